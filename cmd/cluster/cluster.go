@@ -0,0 +1,155 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	client2 "github.com/autom8ter/morpheus/pkg/client"
+	"github.com/spf13/cobra"
+	"time"
+)
+
+var (
+	endpoint string
+	user     string
+	password string
+	timeout  time.Duration
+)
+
+var RootCmd = &cobra.Command{
+	Use:   "cluster",
+	Short: "raft cluster membership operations",
+}
+
+const statusQuery = `
+query {
+  cluster {
+    peer_id
+    state
+    leader
+    servers {
+      id
+      address
+      suffrage
+      leader
+    }
+    stats
+  }
+}`
+
+const joinMutation = `
+mutation ($id: String!, $address: String!) {
+  clusterJoin(id: $id, address: $address)
+}`
+
+const leaveMutation = `
+mutation {
+  clusterLeave
+}`
+
+const removeMutation = `
+mutation ($id: String!) {
+  clusterRemove(id: $id)
+}`
+
+const transferLeadershipMutation = `
+mutation ($id: String, $address: String) {
+  clusterTransferLeadership(id: $id, address: $address)
+}`
+
+func run(query string, vars map[string]interface{}) {
+	client := client2.NewClient(user, password, endpoint, timeout)
+	resp, err := client.Queryx(context.Background(), query, vars)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	bits, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(string(bits))
+}
+
+func getStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "show the raft cluster status",
+		Run: func(_ *cobra.Command, _ []string) {
+			run(statusQuery, nil)
+		},
+	}
+}
+
+func getJoinCmd() *cobra.Command {
+	var id, address string
+	cmd := &cobra.Command{
+		Use:   "join",
+		Short: "add a server to the raft cluster",
+		Run: func(_ *cobra.Command, _ []string) {
+			run(joinMutation, map[string]interface{}{
+				"id":      id,
+				"address": address,
+			})
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "peer id of the server to add")
+	cmd.Flags().StringVar(&address, "address", "", "raft address of the server to add")
+	cmd.MarkFlagRequired("id")
+	cmd.MarkFlagRequired("address")
+	return cmd
+}
+
+func getLeaveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "leave",
+		Short: "remove the server at the endpoint from the raft cluster",
+		Run: func(_ *cobra.Command, _ []string) {
+			run(leaveMutation, nil)
+		},
+	}
+}
+
+func getRemoveCmd() *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "remove a server from the raft cluster",
+		Run: func(_ *cobra.Command, _ []string) {
+			run(removeMutation, map[string]interface{}{
+				"id": id,
+			})
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "peer id of the server to remove")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func getTransferLeadershipCmd() *cobra.Command {
+	var id, address string
+	cmd := &cobra.Command{
+		Use:   "transfer-leadership",
+		Short: "transfer leadership to another server(defaults to the most up to date follower)",
+		Run: func(_ *cobra.Command, _ []string) {
+			vars := map[string]interface{}{}
+			if id != "" {
+				vars["id"] = id
+				vars["address"] = address
+			}
+			run(transferLeadershipMutation, vars)
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "peer id of the server to transfer leadership to")
+	cmd.Flags().StringVar(&address, "address", "", "raft address of the server to transfer leadership to")
+	return cmd
+}
+
+func init() {
+	RootCmd.PersistentFlags().StringVarP(&endpoint, "endpoint", "e", "http://localhost:8080/query", "server endpoint")
+	RootCmd.PersistentFlags().StringVarP(&user, "username", "u", "", "basic auth username")
+	RootCmd.PersistentFlags().StringVarP(&password, "password", "p", "", "basic auth password")
+	RootCmd.PersistentFlags().DurationVarP(&timeout, "timeout", "t", 1*time.Minute, "request timeout")
+	RootCmd.AddCommand(getStatusCmd(), getJoinCmd(), getLeaveCmd(), getRemoveCmd(), getTransferLeadershipCmd())
+}
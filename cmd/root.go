@@ -2,11 +2,12 @@ package cmd
 
 import (
 	"github.com/autom8ter/morpheus/cmd/client"
+	"github.com/autom8ter/morpheus/cmd/cluster"
 	"github.com/spf13/cobra"
 )
 
 func init() {
-	rootCmd.AddCommand(serveCmd, client.RootCmd)
+	rootCmd.AddCommand(serveCmd, client.RootCmd, cluster.RootCmd)
 
 }
 
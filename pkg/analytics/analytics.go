@@ -0,0 +1,151 @@
+package analytics
+
+import (
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/palantir/stacktrace"
+	"sort"
+)
+
+const pageSize = 1000
+
+// Options limits an algorithm to the nodes & relations of the given types. Every type is included if they're empty.
+type Options struct {
+	NodeTypes     []string
+	RelationTypes []string
+}
+
+// Score is the score an algorithm gave a node
+type Score struct {
+	Key   model.Key
+	Score float64
+}
+
+// Group is the component or community an algorithm assigned a node to. Groups are numbered from 0.
+type Group struct {
+	Key   model.Key
+	Group int
+}
+
+// subgraph is an in memory copy of the nodes & relations an algorithm runs on. Nodes are numbered in the order of
+// their keys so that results are deterministic.
+type subgraph struct {
+	keys []model.Key
+	out  [][]int
+	in   [][]int
+}
+
+func (s *subgraph) neighbors(i int) []int {
+	return append(append([]int{}, s.out[i]...), s.in[i]...)
+}
+
+func load(g api.Graph, opts Options) (*subgraph, error) {
+	nodeTypes := opts.NodeTypes
+	if len(nodeTypes) == 0 {
+		nodeTypes = g.NodeTypes()
+	}
+	relationTypes := opts.RelationTypes
+	if len(relationTypes) == 0 {
+		relationTypes = g.RelationTypes()
+	}
+	s := &subgraph{}
+	for _, nodeType := range nodeTypes {
+		var cursor *string
+		for {
+			size := pageSize
+			next, nodes, err := g.RangeNodes(&model.NodeWhere{
+				Cursor:   cursor,
+				Type:     nodeType,
+				PageSize: &size,
+			})
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "failed to range %s nodes", nodeType)
+			}
+			for _, n := range nodes {
+				s.keys = append(s.keys, model.Key{Type: n.Type(), ID: n.ID()})
+			}
+			if len(nodes) < size {
+				break
+			}
+			cursor = &next
+		}
+	}
+	sort.Slice(s.keys, func(i, j int) bool {
+		if s.keys[i].Type != s.keys[j].Type {
+			return s.keys[i].Type < s.keys[j].Type
+		}
+		return s.keys[i].ID < s.keys[j].ID
+	})
+	index := map[model.Key]int{}
+	for i, key := range s.keys {
+		index[key] = i
+	}
+	s.out = make([][]int, len(s.keys))
+	s.in = make([][]int, len(s.keys))
+	for _, relationType := range relationTypes {
+		var cursor *string
+		for {
+			size := pageSize
+			next, relations, err := g.RangeRelations(&model.RelationWhere{
+				Cursor:   cursor,
+				Relation: relationType,
+				PageSize: &size,
+			})
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "failed to range %s relations", relationType)
+			}
+			for _, rel := range relations {
+				source, err := rel.Source()
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "")
+				}
+				target, err := rel.Target()
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "")
+				}
+				// relations are only included if both of their nodes are
+				from, ok := index[model.Key{Type: source.Type(), ID: source.ID()}]
+				if !ok {
+					continue
+				}
+				to, ok := index[model.Key{Type: target.Type(), ID: target.ID()}]
+				if !ok {
+					continue
+				}
+				s.out[from] = append(s.out[from], to)
+				s.in[to] = append(s.in[to], from)
+			}
+			if len(relations) < size {
+				break
+			}
+			cursor = &next
+		}
+	}
+	return s, nil
+}
+
+func sortScores(scores []Score) {
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+}
+
+// groups numbers the labels in the order they're first seen & returns the nodes ordered by group
+func groups(s *subgraph, labels []int) []Group {
+	var (
+		numbers = map[int]int{}
+		results = make([]Group, len(labels))
+	)
+	for i, label := range labels {
+		number, ok := numbers[label]
+		if !ok {
+			number = len(numbers)
+			numbers[label] = number
+		}
+		results[i] = Group{Key: s.keys[i], Group: number}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Group < results[j].Group
+	})
+	return results
+}
@@ -0,0 +1,83 @@
+package analytics_test
+
+import (
+	"github.com/autom8ter/morpheus/pkg/analytics"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/persistence"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func newTestGraph(t *testing.T) api.Graph {
+	dir, err := ioutil.TempDir("", "analytics-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	g, err := persistence.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		g.Close()
+	})
+	nodes := map[string]api.Node{}
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		n, err := g.AddNode("user", id, map[string]interface{}{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes[id] = n
+	}
+	// a, b & c follow each other & d follows e
+	for _, follow := range [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"a", "c"}, {"d", "e"}} {
+		if _, err := nodes[follow[0]].AddRelation(api.Outgoing, "follows", nil, nodes[follow[1]]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return g
+}
+
+func TestAnalytics(t *testing.T) {
+	g := newTestGraph(t)
+	scores, err := analytics.PageRank(g, analytics.Options{}, analytics.DefaultDamping, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var total float64
+	for _, score := range scores {
+		total += score.Score
+	}
+	if scores[0].Key.ID != "c" || total < 0.999 || total > 1.001 {
+		t.Fatalf("unexpected page rank: %v %v", scores[0].Key.ID, total)
+	}
+	scores, err = analytics.DegreeCentrality(g, analytics.Options{}, api.Incoming)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scores[0].Key.ID != "c" || scores[0].Score != 2 {
+		t.Fatalf("unexpected degree centrality: %v %v", scores[0].Key.ID, scores[0].Score)
+	}
+	for _, fn := range []func() ([]analytics.Group, error){
+		func() ([]analytics.Group, error) {
+			return analytics.ConnectedComponents(g, analytics.Options{})
+		},
+		func() ([]analytics.Group, error) {
+			return analytics.Communities(g, analytics.Options{}, 0)
+		},
+	} {
+		groups, err := fn()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string]int{"a": 0, "b": 0, "c": 0, "d": 1, "e": 1}
+		for _, group := range groups {
+			if expected[group.Key.ID] != group.Group {
+				t.Fatalf("unexpected group for %s: %v", group.Key.ID, group.Group)
+			}
+		}
+	}
+}
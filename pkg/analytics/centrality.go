@@ -0,0 +1,30 @@
+package analytics
+
+import (
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/palantir/stacktrace"
+)
+
+// DegreeCentrality scores nodes by their number of relations in the given direction, or in both directions if it's
+// empty. Scores are ordered highest first.
+func DegreeCentrality(g api.Graph, opts Options, direction api.Direction) ([]Score, error) {
+	s, err := load(g, opts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	scores := make([]Score, len(s.keys))
+	for i, key := range s.keys {
+		var degree int
+		switch direction {
+		case api.Outgoing:
+			degree = len(s.out[i])
+		case api.Incoming:
+			degree = len(s.in[i])
+		default:
+			degree = len(s.out[i]) + len(s.in[i])
+		}
+		scores[i] = Score{Key: key, Score: float64(degree)}
+	}
+	sortScores(scores)
+	return scores, nil
+}
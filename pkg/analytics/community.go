@@ -0,0 +1,85 @@
+package analytics
+
+import (
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/palantir/stacktrace"
+)
+
+// ConnectedComponents groups nodes that are connected by relations in either direction(weakly connected components)
+func ConnectedComponents(g api.Graph, opts Options) ([]Group, error) {
+	s, err := load(g, opts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	parents := make([]int, len(s.keys))
+	for i := range parents {
+		parents[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+	for i := range s.keys {
+		for _, j := range s.out[i] {
+			a, b := find(i), find(j)
+			// the lowest node is the root so that components are numbered in the order of their first node
+			if a < b {
+				parents[b] = a
+			} else if b < a {
+				parents[a] = b
+			}
+		}
+	}
+	labels := make([]int, len(s.keys))
+	for i := range labels {
+		labels[i] = find(i)
+	}
+	return groups(s, labels), nil
+}
+
+// Communities detects communities with label propagation. Every node starts in its own community & repeatedly joins
+// the community that most of its neighbors belong to until no node changes community or the iterations run out.
+func Communities(g api.Graph, opts Options, iterations int) ([]Group, error) {
+	if iterations <= 0 {
+		iterations = DefaultIterations
+	}
+	s, err := load(g, opts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	labels := make([]int, len(s.keys))
+	for i := range labels {
+		labels[i] = i
+	}
+	for iteration := 0; iteration < iterations; iteration++ {
+		changed := false
+		for i := range s.keys {
+			neighbors := s.neighbors(i)
+			if len(neighbors) == 0 {
+				continue
+			}
+			counts := map[int]int{}
+			for _, j := range neighbors {
+				counts[labels[j]]++
+			}
+			// ties are broken by the lowest label so that results are deterministic
+			best, bestCount := labels[i], counts[labels[i]]
+			for label, count := range counts {
+				if count > bestCount || (count == bestCount && label < best) {
+					best, bestCount = label, count
+				}
+			}
+			if best != labels[i] {
+				labels[i] = best
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	return groups(s, labels), nil
+}
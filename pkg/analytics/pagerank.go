@@ -0,0 +1,67 @@
+package analytics
+
+import (
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/palantir/stacktrace"
+	"math"
+)
+
+const (
+	DefaultDamping    = 0.85
+	DefaultIterations = 20
+	// tolerance is the total change in scores below which PageRank has converged
+	tolerance = 1e-6
+)
+
+// PageRank scores nodes by the likelihood that a random walk along relations ends at them. The walk follows relations
+// from source to target & jumps to a random node with probability 1-damping. Scores sum to 1 & are ordered highest
+// first.
+func PageRank(g api.Graph, opts Options, damping float64, iterations int) ([]Score, error) {
+	if damping <= 0 || damping >= 1 {
+		return nil, stacktrace.NewError("damping must be between 0 & 1: %v", damping)
+	}
+	if iterations <= 0 {
+		iterations = DefaultIterations
+	}
+	s, err := load(g, opts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	n := float64(len(s.keys))
+	ranks := make([]float64, len(s.keys))
+	for i := range ranks {
+		ranks[i] = 1 / n
+	}
+	for iteration := 0; iteration < iterations; iteration++ {
+		// nodes without outgoing relations spread their rank evenly across every node
+		var dangling float64
+		for i, rank := range ranks {
+			if len(s.out[i]) == 0 {
+				dangling += rank
+			}
+		}
+		next := make([]float64, len(ranks))
+		for i := range next {
+			next[i] = (1-damping)/n + damping*dangling/n
+		}
+		for i, rank := range ranks {
+			for _, j := range s.out[i] {
+				next[j] += damping * rank / float64(len(s.out[i]))
+			}
+		}
+		var delta float64
+		for i := range ranks {
+			delta += math.Abs(next[i] - ranks[i])
+		}
+		ranks = next
+		if delta < tolerance {
+			break
+		}
+	}
+	scores := make([]Score, len(s.keys))
+	for i, key := range s.keys {
+		scores[i] = Score{Key: key, Score: ranks[i]}
+	}
+	sortScores(scores)
+	return scores, nil
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"github.com/autom8ter/morpheus/pkg/graph/model"
 	"github.com/hashicorp/raft"
 )
@@ -26,7 +27,10 @@ type Entity interface {
 	Type() string
 	Properties() (map[string]interface{}, error)
 	GetProperty(name string) (interface{}, error)
+	// SetProperties replaces the properties of the entity
 	SetProperties(properties map[string]interface{}) error
+	// PatchProperties merges the patch into the properties of the entity
+	PatchProperties(patch *model.Patch) error
 	DelProperty(name string) error
 }
 
@@ -36,6 +40,8 @@ type Node interface {
 	DelRelation(relation string, id string) error
 	GetRelation(relation, id string) (Relation, bool, error)
 	Relations(where *model.RelationWhere) (string, []Relation, error)
+	// CountRelations returns the number of the node's relations that match the where clause, ignoring its cursor & page size
+	CountRelations(where *model.RelationWhere) (int, error)
 }
 
 type Relation interface {
@@ -44,16 +50,119 @@ type Relation interface {
 	Target() (Node, error)
 }
 
+// TraverseOptions configures a traversal of the graph from a start node
+type TraverseOptions struct {
+	Start model.Key
+	// Relations limits the traversal to relations of the given types. All relations are followed if it's empty.
+	Relations []string
+	// Direction limits the traversal to relations in the given direction. Both directions are followed if it's empty.
+	Direction Direction
+	Algorithm model.TraverseAlgorithm
+	// MinDepth & MaxDepth bound the depth of the returned nodes. Nodes beyond MaxDepth are never visited.
+	MinDepth int
+	MaxDepth int
+	// NodeFilter excludes nodes that don't match every expression. Excluded nodes are not traversed through.
+	NodeFilter []*model.Expression
+	// RelationFilter excludes relations that don't match every expression
+	RelationFilter []*model.Expression
+	Limit          int
+}
+
+// Traversal is a node visited by a traversal along with the relations that lead to it from the start node
+type Traversal struct {
+	Node  Node
+	Depth int
+	Path  []Relation
+}
+
+// Neighbor is a relation of a node & the node at its other end
+type Neighbor struct {
+	Relation Relation
+	Node     Node
+}
+
+// PathOptions configures a search for a path between two nodes
+type PathOptions struct {
+	From model.Key
+	To   model.Key
+	// Relations limits the path to relations of the given types. All relations are followed if it's empty.
+	Relations []string
+	// Direction limits the path to relations in the given direction. Both directions are followed if it's empty.
+	Direction Direction
+	// MaxDepth is the maximum number of relations in the path
+	MaxDepth int
+	// WeightField is the numeric relation property that weighs each relation of a weighted path
+	WeightField string
+}
+
+// Path is an ordered list of nodes & the relations between them. Relations[i] connects Nodes[i] & Nodes[i+1].
+type Path struct {
+	Nodes     []Node
+	Relations []Relation
+	// Weight is the sum of the weights of the relations. Each relation weighs 1 in an unweighted path.
+	Weight float64
+}
+
+// AggregateOptions configures an aggregation of the nodes of a type
+type AggregateOptions struct {
+	Type string
+	// Filter excludes nodes that don't match it. Every node of the type is aggregated if it's nil.
+	Filter *model.Filter
+	// GroupBy are the properties whose values group the nodes. Every node is in a single group if it's empty.
+	GroupBy []string
+	Metrics []*model.Metric
+}
+
 type Graph interface {
 	GetNode(typee string, id string) (Node, error)
 	AddNode(typee string, id string, properties map[string]interface{}) (Node, error)
-	DelNode(typee string, id string) error
+	// Upsert patches the node whose properties match the values of one of its type's unique constraints, or adds the
+	// node if there isn't one
+	Upsert(upsert *model.Upsert) (Node, error)
+	// DelNode deletes the node. If detach is true, all of the node's relations are deleted along with it, otherwise
+	// the delete fails if the node has any relations.
+	DelNode(typee string, id string, detach bool) error
 	RangeNodes(where *model.NodeWhere) (string, []Node, error)
+	// CountNodes returns the number of nodes that match the where clause, ignoring its cursor, page size & order
+	CountNodes(where *model.NodeWhere) (int, error)
 	NodeTypes() []string
+	// Schema returns the node types, properties & relations that have been observed in the graph
+	Schema() []*model.NodeSchema
+	// DefineNode registers the definition that the properties of nodes of its type are validated against
+	DefineNode(def *model.TypeDefinition) error
+	DropNodeDefinition(nodeType string) error
+	NodeDefinitions() []*model.TypeDefinition
 
 	GetRelation(relation string, id string) (Relation, error)
 	RangeRelations(where *model.RelationWhere) (string, []Relation, error)
+	// CountRelations returns the number of relations that match the where clause, ignoring its cursor, page size & order
+	CountRelations(where *model.RelationWhere) (int, error)
 	RelationTypes() []string
+	// DefineRelation registers the definition that relations of its type are validated against
+	DefineRelation(def *model.TypeDefinition) error
+	DropRelationDefinition(relation string) error
+	RelationDefinitions() []*model.TypeDefinition
+	// Neighbors returns every relation of the node of the given types & direction along with the node at its other end.
+	// Empty relation types & direction match every relation.
+	Neighbors(key model.Key, relations []string, direction Direction) ([]*Neighbor, error)
+	// Traverse walks the graph from the start node & returns the nodes it visits ordered by when they were discovered
+	Traverse(opts *TraverseOptions) ([]*Traversal, error)
+	// ShortestPath returns the path with the fewest relations between two nodes or nil if they're not connected
+	ShortestPath(opts *PathOptions) (*Path, error)
+	// WeightedPath returns the path with the lowest total weight between two nodes or nil if they're not connected
+	WeightedPath(opts *PathOptions) (*Path, error)
+	// Transaction applies the operations in order, either all of them or none of them. It returns the node or relation
+	// written by each operation, or nil for deletes.
+	Transaction(ops []*model.Op) ([]Entity, error)
+	// Aggregate computes the metrics of every group of the matching nodes, ordered by the values they're grouped by
+	Aggregate(opts *AggregateOptions) ([]*model.AggregateRow, error)
+
+	// SubscribeNodes returns a channel of changes to nodes of the given type that match the expressions. The channel is
+	// closed when the context is cancelled.
+	SubscribeNodes(ctx context.Context, nodeType string, expressions []*model.Expression) (<-chan *model.NodeChange, error)
+	// SubscribeRelations returns a channel of changes to relations of the given type that match the expressions. The
+	// channel is closed when the context is cancelled.
+	SubscribeRelations(ctx context.Context, relation string, expressions []*model.Expression) (<-chan *model.RelationChange, error)
 
 	Close() error
 	FSM() raft.FSM
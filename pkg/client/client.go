@@ -63,7 +63,7 @@ func (c *Client) checkToken() error {
 			return nil
 		}
 	}
-	var loginQuery = fmt.Sprintf(`query { login(username: "%s", password: "%s") }`, c.username, c.password)
+	var loginQuery = fmt.Sprintf(`mutation { login(username: "%s", password: "%s") }`, c.username, c.password)
 	req := graphql.NewRequest(loginQuery)
 	//req.Var("user", c.username)
 	//req.Var("password", c.password)
@@ -12,10 +12,10 @@ import (
 )
 
 const addRelation = `
-query ($key: Key!, $relationship: String!, $nodeKey: Key!) {
+mutation ($key: Key!, $relationship: String!, $nodeKey: Key!) {
   get(key: $key){
     id
-    addRelationship(relationship: $relationship, nodeKey: $nodeKey) {
+    addRelation(relation: $relationship, nodeKey: $nodeKey) {
       type
     }
   }
@@ -65,7 +65,7 @@ func syncActors(ctx context.Context, db *sqlx.DB, client *client.Client) error {
 		return stacktrace.Propagate(err, "")
 	}
 	const addActor = `
-query ($id: String, $first_name: String!, $last_name: String!, $gender: String!) {
+mutation ($id: String, $first_name: String!, $last_name: String!, $gender: String!) {
     add(add: {
         id: $id,
         type: "actor",
@@ -99,7 +99,7 @@ func syncMovies(ctx context.Context, db *sqlx.DB, client *client.Client) error {
 		return stacktrace.Propagate(err, "")
 	}
 	const addMovie = `
-query ($id: String, $name: String!, $year: String!, $rank: String!) {
+mutation ($id: String, $name: String!, $year: Int!, $rank: Float) {
     add(add: {
         id: $id,
         type: "movie",
@@ -117,8 +117,8 @@ query ($id: String, $name: String!, $year: String!, $rank: String!) {
 		_, err := client.Queryx(ctx, addMovie, map[string]interface{}{
 			"id":   cast.ToString(movie["id"]),
 			"name": cast.ToString(movie["name"]),
-			"year": cast.ToString(movie["year"]),
-			"rank": cast.ToString(movie["rank"]),
+			"year": cast.ToInt(cast.ToString(movie["year"])),
+			"rank": cast.ToFloat64(cast.ToString(movie["rank"])),
 		})
 		if err != nil {
 			return stacktrace.Propagate(err, "")
@@ -133,7 +133,7 @@ func syncDirectors(ctx context.Context, db *sqlx.DB, client *client.Client) erro
 		return stacktrace.Propagate(err, "")
 	}
 	const addDirector = `
-query ($id: String, $first_name: String!, $last_name: String!) {
+mutation ($id: String, $first_name: String!, $last_name: String!) {
     add(add: {
         id: $id,
         type: "director",
@@ -165,7 +165,7 @@ func syncRoles(ctx context.Context, db *sqlx.DB, client *client.Client) error {
 		return stacktrace.Propagate(err, "")
 	}
 	const addRole = `
-query ($role: String) {
+mutation ($role: String) {
    add(add: {
        id: $role,
        type: "role",
@@ -245,7 +245,7 @@ func syncMovieGenres(ctx context.Context, db *sqlx.DB, client *client.Client) er
 		return stacktrace.Propagate(err, "")
 	}
 	const addGenre = `
-query ($genre: String) {
+mutation ($genre: String) {
    add(add: {
        id: $genre,
        type: "genre"
@@ -26,6 +26,8 @@ func LoadConfig(configFile string) (*Config, error) {
 	viper.SetDefault("features.apollo_tracing", false)
 	viper.SetDefault("features.playground", true)
 	viper.SetDefault("server.raft_cluster", "")
+	viper.SetDefault("server.raft_discovery", "static")
+	viper.SetDefault("server.raft_gossip_port", 7946)
 
 	viper.SetDefault("auth.signing_secret", "default_secret")
 	viper.SetDefault("auth.token_ttl", 24*time.Hour)
@@ -52,12 +54,28 @@ func LoadConfig(configFile string) (*Config, error) {
 }
 
 type Server struct {
-	Port          int    `mapstructure:"port"`
-	RaftCluster   string `mapstructure:"raft_cluster"`
-	RaftSecret    string `mapstructure:"raft_secret"`
+	Port int `mapstructure:"port"`
+	// RaftCluster is a comma separated list of peer addresses(static discovery) or gossip seed addresses(gossip discovery)
+	RaftCluster string `mapstructure:"raft_cluster"`
+	RaftSecret  string `mapstructure:"raft_secret"`
+	// RaftPeerID uniquely identifies the server within the cluster. It defaults to a hash of the hostname.
+	RaftPeerID    string `mapstructure:"raft_peer_id"`
 	RaftBroadcast string `mapstructure:"raft_broadcast"`
-	TLSKey        string `mapstructure:"tls_key"`
-	TLSCert       string `mapstructure:"tls_cert"`
+	// RaftDiscovery is the peer discovery mechanism: static, dns or gossip
+	RaftDiscovery string `mapstructure:"raft_discovery"`
+	// RaftExpect is the exact number of servers to discover before the server with the lowest peer id bootstraps a new
+	// cluster. If it's 0, the server only joins existing clusters.
+	RaftExpect     int    `mapstructure:"raft_expect"`
+	RaftDNS        string `mapstructure:"raft_dns"`
+	RaftDNSSRV     bool   `mapstructure:"raft_dns_srv"`
+	RaftGossipPort int    `mapstructure:"raft_gossip_port"`
+	// RaftTLSCA, RaftTLSCert & RaftTLSKey are PEM encoded & enable mutual TLS between raft peers. Peers must present a
+	// certificate signed by the CA that is valid for the address they are dialed on.
+	RaftTLSCA   string `mapstructure:"raft_tls_ca"`
+	RaftTLSCert string `mapstructure:"raft_tls_cert"`
+	RaftTLSKey  string `mapstructure:"raft_tls_key"`
+	TLSKey      string `mapstructure:"tls_key"`
+	TLSCert     string `mapstructure:"tls_cert"`
 }
 
 type Database struct {
@@ -1,8 +1,11 @@
 package constants
 
 import (
+	"fmt"
 	"github.com/palantir/stacktrace"
 	"net/http"
+	"sort"
+	"strings"
 )
 
 var (
@@ -10,4 +13,27 @@ var (
 	ErrUnauthorized = stacktrace.NewErrorWithCode(http.StatusUnauthorized, "unauthorized")
 	ErrForbidden    = stacktrace.NewErrorWithCode(http.StatusForbidden, "forbidden")
 	ErrServerError  = stacktrace.NewErrorWithCode(http.StatusInternalServerError, "internal server error")
+	ErrConflict     = stacktrace.NewErrorWithCode(http.StatusConflict, "conflict")
+	// ErrVersionConflict is returned when a write's version precondition doesn't match the stored version
+	ErrVersionConflict = stacktrace.NewErrorWithCode(http.StatusConflict, "version conflict")
+	ErrUnavailable     = stacktrace.NewErrorWithCode(http.StatusServiceUnavailable, "unavailable")
 )
+
+// ValidationError is returned when a write violates the definition of a node or relation type. Violations maps each
+// invalid property to the reason it's invalid.
+type ValidationError struct {
+	Type       string
+	Violations map[string]string
+}
+
+func (e *ValidationError) Error() string {
+	var fields []string
+	for field := range e.Violations {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for i, field := range fields {
+		fields[i] = fmt.Sprintf("%s: %s", field, e.Violations[field])
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Type, strings.Join(fields, "; "))
+}
@@ -0,0 +1,118 @@
+package cypher
+
+import "github.com/autom8ter/morpheus/pkg/api"
+
+// Statement is a parsed query: MATCH ... [WHERE ...] RETURN ... [ORDER BY ...] [SKIP ...] [LIMIT ...]
+type Statement struct {
+	Patterns []*Pattern
+	Where    Expr
+	Return   *Return
+	OrderBy  []*SortItem
+	Skip     Expr
+	Limit    Expr
+}
+
+// Pattern is a chain of nodes & the relationships between them. Rels[i] connects Nodes[i] & Nodes[i+1].
+type Pattern struct {
+	Nodes []*NodePattern
+	Rels  []*RelPattern
+}
+
+type NodePattern struct {
+	// Var is the variable the node is bound to. Anonymous nodes are given a hidden variable.
+	Var   string
+	Label string
+	Props map[string]Expr
+}
+
+type RelPattern struct {
+	Var   string
+	Types []string
+	// Direction is relative to the pattern read from left to right. It's empty if the relationship is undirected.
+	Direction api.Direction
+	// VarLength is true for variable length relationships(*min..max) which are bound to a list of relationships
+	VarLength bool
+	MinHops   int
+	MaxHops   int
+	Props     map[string]Expr
+}
+
+type Return struct {
+	Distinct bool
+	// Star returns every named variable
+	Star  bool
+	Items []*ReturnItem
+}
+
+type ReturnItem struct {
+	Expr Expr
+	// Name is the alias of the item or the text of its expression
+	Name string
+}
+
+type SortItem struct {
+	Expr Expr
+	// Text is the text of the expression, which is matched against the names of the returned columns
+	Text string
+	Desc bool
+}
+
+// Expr is an expression in a WHERE, RETURN or ORDER BY clause
+type Expr interface {
+	isExpr()
+}
+
+type Literal struct {
+	Value interface{}
+}
+
+type Param struct {
+	Name string
+}
+
+type Variable struct {
+	Name string
+}
+
+type Property struct {
+	Expr Expr
+	Key  string
+}
+
+type Binary struct {
+	Op    string
+	Left  Expr
+	Right Expr
+}
+
+type Unary struct {
+	Op   string
+	Expr Expr
+}
+
+type IsNull struct {
+	Expr Expr
+	Not  bool
+}
+
+type List struct {
+	Items []Expr
+}
+
+type Call struct {
+	Name     string
+	Distinct bool
+	// Star is true for count(*)
+	Star bool
+	Args []Expr
+}
+
+func (Literal) isExpr()  {}
+func (Param) isExpr()    {}
+func (Variable) isExpr() {}
+func (Property) isExpr() {}
+func (Binary) isExpr()   {}
+func (Unary) isExpr()    {}
+func (IsNull) isExpr()   {}
+func (List) isExpr()     {}
+func (Call) isExpr()     {}
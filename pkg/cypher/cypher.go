@@ -0,0 +1,454 @@
+package cypher
+
+import (
+	"fmt"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/palantir/stacktrace"
+	"github.com/spf13/cast"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	// defaultMaxHops is the maximum length of a variable length relationship without an upper bound
+	defaultMaxHops = 10
+	pageSize       = 1000
+)
+
+// Result is the table returned by a query
+type Result struct {
+	Columns []string
+	Rows    [][]interface{}
+}
+
+// Query parses & runs a query against the graph. Patterns are matched from the node that's cheapest to find, which is
+// looked up with the field indexes when it has a label & a property predicate, & relationships are expanded with the
+// node relation index.
+func Query(g api.Graph, query string, params map[string]interface{}) (*Result, error) {
+	stmt, err := Parse(query)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	e := &executor{g: g, stmt: stmt, params: params}
+	result, err := e.run()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return result, nil
+}
+
+type executor struct {
+	g      api.Graph
+	stmt   *Statement
+	params map[string]interface{}
+	// predicates are the WHERE conditions on a single property of a variable that can be pushed down to the graph
+	predicates map[string][]*model.Expression
+}
+
+// step matches the relationship at rel from the bound node at from to the node at to
+type step struct {
+	rel  int
+	from int
+	to   int
+}
+
+func (e *executor) run() (*Result, error) {
+	e.predicates = map[string][]*model.Expression{}
+	if err := e.pushdown(e.stmt.Where); err != nil {
+		return nil, err
+	}
+	p, err := e.newProjection()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := e.match(0, row{}, p.add); err != nil {
+		return nil, err
+	}
+	return p.result()
+}
+
+// pushdown collects the conditions of the WHERE clause that compare a property of a variable to a constant
+func (e *executor) pushdown(where Expr) error {
+	binary, ok := where.(*Binary)
+	if !ok {
+		return nil
+	}
+	if binary.Op == "AND" {
+		if err := e.pushdown(binary.Left); err != nil {
+			return err
+		}
+		return e.pushdown(binary.Right)
+	}
+	operators := map[string]model.Operator{
+		"=":           model.OperatorEq,
+		"<>":          model.OperatorNeq,
+		"<":           model.OperatorLt,
+		">":           model.OperatorGt,
+		"<=":          model.OperatorLte,
+		">=":          model.OperatorGte,
+		"STARTS WITH": model.OperatorHasPrefix,
+		"ENDS WITH":   model.OperatorHasSuffix,
+		"CONTAINS":    model.OperatorContains,
+	}
+	flipped := map[model.Operator]model.Operator{
+		model.OperatorLt:  model.OperatorGt,
+		model.OperatorGt:  model.OperatorLt,
+		model.OperatorLte: model.OperatorGte,
+		model.OperatorGte: model.OperatorLte,
+	}
+	op, ok := operators[binary.Op]
+	if !ok {
+		return nil
+	}
+	prop, value := binary.Left, binary.Right
+	if _, ok := prop.(*Property); !ok {
+		prop, value = value, prop
+		switch op {
+		case model.OperatorEq, model.OperatorNeq:
+		default:
+			if op, ok = flipped[op]; !ok {
+				return nil
+			}
+		}
+	}
+	property, ok := prop.(*Property)
+	if !ok {
+		return nil
+	}
+	variable, ok := property.Expr.(*Variable)
+	if !ok {
+		return nil
+	}
+	switch value.(type) {
+	case *Literal, *Param:
+	default:
+		return nil
+	}
+	val, err := e.eval(value, row{})
+	if err != nil {
+		return err
+	}
+	if val == nil {
+		return nil
+	}
+	exp := &model.Expression{Key: property.Key, Operator: op, Value: val}
+	// equality is first so that it's answered by the field index
+	if op == model.OperatorEq {
+		e.predicates[variable.Name] = append([]*model.Expression{exp}, e.predicates[variable.Name]...)
+	} else {
+		e.predicates[variable.Name] = append(e.predicates[variable.Name], exp)
+	}
+	return nil
+}
+
+// match matches the patterns from i onwards & calls emit with every row that matches them & the WHERE clause. It stops
+// when emit returns false.
+func (e *executor) match(i int, r row, emit func(r row) (bool, error)) (bool, error) {
+	if i == len(e.stmt.Patterns) {
+		if e.stmt.Where != nil {
+			passed, err := e.eval(e.stmt.Where, r)
+			if err != nil {
+				return false, err
+			}
+			if passed != true {
+				return true, nil
+			}
+		}
+		return emit(r)
+	}
+	pattern := e.stmt.Patterns[i]
+	anchor := e.anchor(pattern, r)
+	steps := planSteps(pattern, anchor)
+	return e.candidates(pattern.Nodes[anchor], r, func(n api.Node) (bool, error) {
+		matched, ok, err := e.bindNode(pattern.Nodes[anchor], r, n)
+		if err != nil || !ok {
+			return true, err
+		}
+		return e.walk(pattern, steps, matched, func(r row) (bool, error) {
+			return e.match(i+1, r, emit)
+		})
+	})
+}
+
+// anchor returns the index of the node the pattern is matched from: a node that's already bound, otherwise the first
+// node that can be found with a field index, otherwise the first node with a label
+func (e *executor) anchor(pattern *Pattern, r row) int {
+	best, bestScore := 0, -1
+	for i, node := range pattern.Nodes {
+		var score int
+		switch {
+		case r[node.Var] != nil:
+			score = 3
+		case node.Label != "" && (len(node.Props) > 0 || len(e.predicates[node.Var]) > 0):
+			score = 2
+		case node.Label != "":
+			score = 1
+		}
+		if score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+// planSteps returns the order the relationships of the pattern are matched in: from the anchor to the end of the
+// pattern & then from the anchor to the start
+func planSteps(pattern *Pattern, anchor int) []step {
+	var steps []step
+	for i := anchor; i < len(pattern.Rels); i++ {
+		steps = append(steps, step{rel: i, from: i, to: i + 1})
+	}
+	for i := anchor - 1; i >= 0; i-- {
+		steps = append(steps, step{rel: i, from: i + 1, to: i})
+	}
+	return steps
+}
+
+// candidates calls fn with the nodes that may match the node pattern
+func (e *executor) candidates(node *NodePattern, r row, fn func(n api.Node) (bool, error)) (bool, error) {
+	if bound, ok := r[node.Var].(api.Node); ok {
+		return fn(bound)
+	}
+	expressions, err := e.nodeExpressions(node)
+	if err != nil {
+		return false, err
+	}
+	types := []string{node.Label}
+	if node.Label == "" {
+		types = e.g.NodeTypes()
+	}
+	for _, nodeType := range types {
+		var cursor *string
+		for {
+			size := pageSize
+			next, nodes, err := e.g.RangeNodes(&model.NodeWhere{
+				Cursor:      cursor,
+				Type:        nodeType,
+				Expressions: expressions,
+				PageSize:    &size,
+			})
+			if err != nil {
+				return false, stacktrace.Propagate(err, "")
+			}
+			for _, n := range nodes {
+				cont, err := fn(n)
+				if err != nil || !cont {
+					return cont, err
+				}
+			}
+			if len(nodes) < size {
+				break
+			}
+			cursor = &next
+		}
+	}
+	return true, nil
+}
+
+// nodeExpressions returns the expressions that nodes matching the pattern must match
+func (e *executor) nodeExpressions(node *NodePattern) ([]*model.Expression, error) {
+	var expressions []*model.Expression
+	keys := make([]string, 0, len(node.Props))
+	for key := range node.Props {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		// properties that refer to other variables are only checked once the node is bound
+		switch node.Props[key].(type) {
+		case *Literal, *Param:
+		default:
+			continue
+		}
+		val, err := e.eval(node.Props[key], row{})
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, &model.Expression{Key: key, Operator: model.OperatorEq, Value: val})
+	}
+	return append(expressions, e.predicates[node.Var]...), nil
+}
+
+// bindNode binds the node to the variable of the node pattern if it matches the pattern
+func (e *executor) bindNode(node *NodePattern, r row, n api.Node) (row, bool, error) {
+	if bound, ok := r[node.Var]; ok {
+		if eq := equal(bound, n); eq != true {
+			return nil, false, nil
+		}
+		return r, true, nil
+	}
+	if node.Label != "" && n.Type() != node.Label {
+		return nil, false, nil
+	}
+	ok, err := e.matchProps(node.Props, r, n)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return r.with(node.Var, n), true, nil
+}
+
+// walk matches the remaining steps of the pattern
+func (e *executor) walk(pattern *Pattern, steps []step, r row, emit func(r row) (bool, error)) (bool, error) {
+	if len(steps) == 0 {
+		return emit(r)
+	}
+	s := steps[0]
+	rel := pattern.Rels[s.rel]
+	from := r[pattern.Nodes[s.from].Var].(api.Node)
+	direction := rel.Direction
+	if s.from > s.to && direction != "" {
+		direction = direction.Opposite()
+	}
+	if !rel.VarLength {
+		neighbors, err := e.g.Neighbors(model.Key{Type: from.Type(), ID: from.ID()}, rel.Types, direction)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+		for _, neighbor := range neighbors {
+			// a relationship is only matched once by a pattern
+			if usedBy(pattern, r, neighbor.Relation) {
+				continue
+			}
+			ok, err := e.matchProps(rel.Props, r, neighbor.Relation)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				continue
+			}
+			next, ok, err := e.bindNode(pattern.Nodes[s.to], r, neighbor.Node)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				continue
+			}
+			cont, err := e.walk(pattern, steps[1:], next.with(rel.Var, neighbor.Relation), emit)
+			if err != nil || !cont {
+				return cont, err
+			}
+		}
+		return true, nil
+	}
+	// variable length relationships are matched by the shortest path to each node within range
+	var filter []*model.Expression
+	for key, expr := range rel.Props {
+		val, err := e.eval(expr, r)
+		if err != nil {
+			return false, err
+		}
+		filter = append(filter, &model.Expression{Key: key, Operator: model.OperatorEq, Value: val})
+	}
+	traversals, err := e.g.Traverse(&api.TraverseOptions{
+		Start:          model.Key{Type: from.Type(), ID: from.ID()},
+		Relations:      rel.Types,
+		Direction:      direction,
+		MinDepth:       rel.MinHops,
+		MaxDepth:       rel.MaxHops,
+		RelationFilter: filter,
+		Limit:          math.MaxInt32,
+	})
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	for _, traversal := range traversals {
+		if traversal.Depth > rel.MaxHops {
+			continue
+		}
+		next, ok, err := e.bindNode(pattern.Nodes[s.to], r, traversal.Node)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			continue
+		}
+		path := traversal.Path
+		if s.from > s.to {
+			path = reversed(path)
+		}
+		cont, err := e.walk(pattern, steps[1:], next.with(rel.Var, path), emit)
+		if err != nil || !cont {
+			return cont, err
+		}
+	}
+	return true, nil
+}
+
+// matchProps returns whether the node or relationship has the properties of its pattern
+func (e *executor) matchProps(props map[string]Expr, r row, ent api.Entity) (bool, error) {
+	for key, expr := range props {
+		val, err := e.eval(expr, r)
+		if err != nil {
+			return false, err
+		}
+		prop, err := property(ent, key)
+		if err != nil {
+			return false, err
+		}
+		if equal(prop, val) != true {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func usedBy(pattern *Pattern, r row, relation api.Relation) bool {
+	for _, rel := range pattern.Rels {
+		if bound, ok := r[rel.Var].(api.Relation); ok && equal(bound, relation) == true {
+			return true
+		}
+	}
+	return false
+}
+
+func reversed(path []api.Relation) []api.Relation {
+	rev := make([]api.Relation, len(path))
+	for i, rel := range path {
+		rev[len(path)-1-i] = rel
+	}
+	return rev
+}
+
+// variables returns the named variables of the patterns in the order they're declared
+func (e *executor) variables() []string {
+	var (
+		names []string
+		seen  = map[string]bool{}
+	)
+	add := func(name string) {
+		if !strings.HasPrefix(name, "#") && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, pattern := range e.stmt.Patterns {
+		for i, node := range pattern.Nodes {
+			add(node.Var)
+			if i < len(pattern.Rels) {
+				add(pattern.Rels[i].Var)
+			}
+		}
+	}
+	return names
+}
+
+func (e *executor) intParam(expr Expr, name string) (int, error) {
+	if expr == nil {
+		return -1, nil
+	}
+	val, err := e.eval(expr, row{})
+	if err != nil {
+		return 0, err
+	}
+	i, err := cast.ToIntE(val)
+	if err != nil || i < 0 {
+		return 0, stacktrace.NewError("cypher: %s expects a positive integer, got %v", name, val)
+	}
+	return i, nil
+}
+
+func aggregateKey(call *Call) string {
+	return fmt.Sprintf("#aggregate%p", call)
+}
@@ -0,0 +1,106 @@
+package cypher_test
+
+import (
+	"fmt"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/cypher"
+	"github.com/autom8ter/morpheus/pkg/persistence"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func newTestGraph(t *testing.T) api.Graph {
+	dir, err := ioutil.TempDir("", "cypher-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	g, err := persistence.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		g.Close()
+	})
+	people := map[string]api.Node{}
+	for _, name := range []string{"clint", "gene", "morgan"} {
+		n, err := g.AddNode("person", name, map[string]interface{}{"name": name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		people[name] = n
+	}
+	movies := map[string]api.Node{}
+	for title, year := range map[string]int{"unforgiven": 1992, "million dollar baby": 2004, "gran torino": 2008} {
+		n, err := g.AddNode("movie", title, map[string]interface{}{"title": title, "year": year})
+		if err != nil {
+			t.Fatal(err)
+		}
+		movies[title] = n
+	}
+	for _, rel := range [][3]string{
+		{"clint", "directed", "unforgiven"},
+		{"clint", "acted_in", "unforgiven"},
+		{"gene", "acted_in", "unforgiven"},
+		{"morgan", "acted_in", "unforgiven"},
+		{"clint", "directed", "million dollar baby"},
+		{"clint", "acted_in", "million dollar baby"},
+		{"morgan", "acted_in", "million dollar baby"},
+		{"clint", "directed", "gran torino"},
+	} {
+		if _, err := people[rel[0]].AddRelation(api.Outgoing, rel[1], nil, movies[rel[2]]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return g
+}
+
+func TestQuery(t *testing.T) {
+	g := newTestGraph(t)
+	type testCase struct {
+		query  string
+		params map[string]interface{}
+		rows   string
+	}
+	for _, tc := range []testCase{
+		{
+			query: `MATCH (p:person)-[:directed]->(m:movie)<-[:acted_in]-(p) RETURN m.title AS title ORDER BY title`,
+			rows:  "[[million dollar baby] [unforgiven]]",
+		},
+		{
+			query:  `MATCH (p:person {name: $name})-[:acted_in]->(m:movie) WHERE m.year > 2000 RETURN m.title`,
+			params: map[string]interface{}{"name": "morgan"},
+			rows:   "[[million dollar baby]]",
+		},
+		{
+			query: `MATCH (p:person)-[:acted_in]->(m:movie) RETURN p.name, count(*) AS movies ORDER BY movies DESC, p.name SKIP 1 LIMIT 1`,
+			rows:  "[[morgan 2]]",
+		},
+		{
+			query: `MATCH (a:person)-[:acted_in]->(:movie)<-[:acted_in]-(b:person) WHERE a.name < b.name RETURN DISTINCT a.name, b.name ORDER BY a.name, b.name`,
+			rows:  "[[clint gene] [clint morgan] [gene morgan]]",
+		},
+		{
+			query: `MATCH (m:movie) WHERE NOT (m.title STARTS WITH 'gran') RETURN min(m.year), max(m.year), sum(m.year)`,
+			rows:  "[[1992 2004 3996]]",
+		},
+		{
+			query: `MATCH (a:person {name: 'gene'})-[*2..2]-(b:person) RETURN b.name ORDER BY b.name`,
+			rows:  "[[clint] [morgan]]",
+		},
+	} {
+		result, err := cypher.Query(g, tc.query, tc.params)
+		if err != nil {
+			t.Fatal(tc.query, err)
+		}
+		if rows := fmt.Sprint(result.Rows); rows != tc.rows {
+			t.Fatalf("%s: expected %s got %s", tc.query, tc.rows, rows)
+		}
+	}
+	if _, err := cypher.Query(g, `MATCH (p:person) RETURN size(count(*))`, nil); err == nil {
+		t.Fatal("expected nested aggregate error")
+	}
+}
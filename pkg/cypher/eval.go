@@ -0,0 +1,655 @@
+package cypher
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/palantir/stacktrace"
+	"github.com/spf13/cast"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// row binds variables to nodes(api.Node), relationships(api.Relation), variable length relationships([]api.Relation)
+// & the values of returned columns
+type row map[string]interface{}
+
+func (r row) with(name string, value interface{}) row {
+	next := make(row, len(r)+1)
+	for k, v := range r {
+		next[k] = v
+	}
+	next[name] = value
+	return next
+}
+
+// aggregates are the functions that aggregate rows
+var aggregates = map[string]bool{
+	"count":   true,
+	"sum":     true,
+	"avg":     true,
+	"min":     true,
+	"max":     true,
+	"collect": true,
+}
+
+func isAggregate(e Expr) bool {
+	call, ok := e.(*Call)
+	return ok && aggregates[call.Name]
+}
+
+// containsAggregate returns whether the expression calls an aggregate function anywhere other than at its root
+func containsAggregate(e Expr) bool {
+	switch e := e.(type) {
+	case *Call:
+		for _, arg := range e.Args {
+			if isAggregate(arg) || containsAggregate(arg) {
+				return true
+			}
+		}
+	case *Property:
+		return isAggregate(e.Expr) || containsAggregate(e.Expr)
+	case *Binary:
+		return isAggregate(e.Left) || containsAggregate(e.Left) || isAggregate(e.Right) || containsAggregate(e.Right)
+	case *Unary:
+		return isAggregate(e.Expr) || containsAggregate(e.Expr)
+	case *IsNull:
+		return isAggregate(e.Expr) || containsAggregate(e.Expr)
+	case *List:
+		for _, item := range e.Items {
+			if isAggregate(item) || containsAggregate(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// eval evaluates the expression against the row. nil is null, which makes comparisons null as well.
+func (e *executor) eval(expr Expr, r row) (interface{}, error) {
+	switch expr := expr.(type) {
+	case *Literal:
+		return expr.Value, nil
+	case *Param:
+		val, ok := e.params[expr.Name]
+		if !ok {
+			return nil, stacktrace.NewError("cypher: missing parameter $%s", expr.Name)
+		}
+		return normalize(val), nil
+	case *Variable:
+		val, ok := r[expr.Name]
+		if !ok {
+			return nil, stacktrace.NewError("cypher: undefined variable %s", expr.Name)
+		}
+		return val, nil
+	case *Property:
+		val, err := e.eval(expr.Expr, r)
+		if err != nil {
+			return nil, err
+		}
+		return property(val, expr.Key)
+	case *List:
+		var list []interface{}
+		for _, item := range expr.Items {
+			val, err := e.eval(item, r)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, val)
+		}
+		return list, nil
+	case *IsNull:
+		val, err := e.eval(expr.Expr, r)
+		if err != nil {
+			return nil, err
+		}
+		return (val == nil) != expr.Not, nil
+	case *Unary:
+		val, err := e.eval(expr.Expr, r)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+		switch expr.Op {
+		case "NOT":
+			b, ok := val.(bool)
+			if !ok {
+				return nil, stacktrace.NewError("cypher: NOT expects a boolean, got %v", val)
+			}
+			return !b, nil
+		default:
+			switch val := val.(type) {
+			case int64:
+				return -val, nil
+			case float64:
+				return -val, nil
+			}
+			return nil, stacktrace.NewError("cypher: - expects a number, got %v", val)
+		}
+	case *Binary:
+		return e.binary(expr, r)
+	case *Call:
+		if aggregates[expr.Name] {
+			// aggregates are computed by the projection & bound to the column they're returned as
+			if val, ok := r[aggregateKey(expr)]; ok {
+				return val, nil
+			}
+			return nil, stacktrace.NewError("cypher: aggregate function %s is only supported as a returned column", expr.Name)
+		}
+		var args []interface{}
+		for _, arg := range expr.Args {
+			val, err := e.eval(arg, r)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, val)
+		}
+		return call(expr.Name, args)
+	}
+	return nil, stacktrace.NewError("cypher: unsupported expression %T", expr)
+}
+
+func (e *executor) binary(expr *Binary, r row) (interface{}, error) {
+	left, err := e.eval(expr.Left, r)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Op {
+	case "AND", "OR", "XOR":
+		right, err := e.eval(expr.Right, r)
+		if err != nil {
+			return nil, err
+		}
+		return logical(expr.Op, left, right)
+	}
+	right, err := e.eval(expr.Right, r)
+	if err != nil {
+		return nil, err
+	}
+	if expr.Op == "IN" {
+		if right == nil {
+			return nil, nil
+		}
+		list, ok := right.([]interface{})
+		if !ok {
+			return nil, stacktrace.NewError("cypher: IN expects a list, got %v", right)
+		}
+		var null bool
+		for _, item := range list {
+			eq := equal(left, item)
+			if eq == nil {
+				null = true
+			} else if eq.(bool) {
+				return true, nil
+			}
+		}
+		if null {
+			return nil, nil
+		}
+		return false, nil
+	}
+	if left == nil || right == nil {
+		return nil, nil
+	}
+	switch expr.Op {
+	case "=":
+		return equal(left, right), nil
+	case "<>":
+		eq := equal(left, right)
+		if eq == nil {
+			return nil, nil
+		}
+		return !eq.(bool), nil
+	case "<", ">", "<=", ">=":
+		cmp, ok := compare(left, right)
+		if !ok {
+			return nil, nil
+		}
+		switch expr.Op {
+		case "<":
+			return cmp < 0, nil
+		case ">":
+			return cmp > 0, nil
+		case "<=":
+			return cmp <= 0, nil
+		default:
+			return cmp >= 0, nil
+		}
+	case "STARTS WITH", "ENDS WITH", "CONTAINS":
+		l, lok := left.(string)
+		r, rok := right.(string)
+		if !lok || !rok {
+			return nil, nil
+		}
+		switch expr.Op {
+		case "STARTS WITH":
+			return strings.HasPrefix(l, r), nil
+		case "ENDS WITH":
+			return strings.HasSuffix(l, r), nil
+		default:
+			return strings.Contains(l, r), nil
+		}
+	case "+":
+		if l, ok := left.([]interface{}); ok {
+			if r, ok := right.([]interface{}); ok {
+				return append(append([]interface{}{}, l...), r...), nil
+			}
+			return append(append([]interface{}{}, l...), right), nil
+		}
+		if l, ok := left.(string); ok {
+			return l + cast.ToString(right), nil
+		}
+		if r, ok := right.(string); ok {
+			return cast.ToString(left) + r, nil
+		}
+		return arithmetic(expr.Op, left, right)
+	default:
+		return arithmetic(expr.Op, left, right)
+	}
+}
+
+func logical(op string, left, right interface{}) (interface{}, error) {
+	l, lok := left.(bool)
+	r, rok := right.(bool)
+	if (left != nil && !lok) || (right != nil && !rok) {
+		return nil, stacktrace.NewError("cypher: %s expects booleans, got %v & %v", op, left, right)
+	}
+	switch op {
+	case "AND":
+		if (left != nil && !l) || (right != nil && !r) {
+			return false, nil
+		}
+		if left == nil || right == nil {
+			return nil, nil
+		}
+		return true, nil
+	case "OR":
+		if (left != nil && l) || (right != nil && r) {
+			return true, nil
+		}
+		if left == nil || right == nil {
+			return nil, nil
+		}
+		return false, nil
+	default:
+		if left == nil || right == nil {
+			return nil, nil
+		}
+		return l != r, nil
+	}
+}
+
+func arithmetic(op string, left, right interface{}) (interface{}, error) {
+	li, lint := left.(int64)
+	ri, rint := right.(int64)
+	if lint && rint {
+		switch op {
+		case "+":
+			return li + ri, nil
+		case "-":
+			return li - ri, nil
+		case "*":
+			return li * ri, nil
+		case "/", "%":
+			if ri == 0 {
+				return nil, stacktrace.NewError("cypher: division by zero")
+			}
+			if op == "/" {
+				return li / ri, nil
+			}
+			return li % ri, nil
+		}
+	}
+	if !isNumber(left) || !isNumber(right) {
+		return nil, stacktrace.NewError("cypher: %s expects numbers, got %v & %v", op, left, right)
+	}
+	l, r := cast.ToFloat64(left), cast.ToFloat64(right)
+	switch op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		return l / r, nil
+	case "%":
+		return math.Mod(l, r), nil
+	}
+	return nil, stacktrace.NewError("cypher: unsupported operator %s", op)
+}
+
+// property returns the property of a node, relationship or map
+func property(val interface{}, key string) (interface{}, error) {
+	switch val := val.(type) {
+	case nil:
+		return nil, nil
+	case api.Entity:
+		props, err := val.Properties()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		return normalize(props[key]), nil
+	case map[string]interface{}:
+		return normalize(val[key]), nil
+	}
+	return nil, stacktrace.NewError("cypher: cannot get property %s of %v", key, val)
+}
+
+func call(name string, args []interface{}) (interface{}, error) {
+	arg := func(i int) interface{} {
+		if i < len(args) {
+			return args[i]
+		}
+		return nil
+	}
+	switch name {
+	case "id":
+		if ent, ok := arg(0).(api.Entity); ok {
+			return ent.ID(), nil
+		}
+		return nil, nil
+	case "type":
+		if rel, ok := arg(0).(api.Relation); ok {
+			return rel.Type(), nil
+		}
+		return nil, nil
+	case "labels":
+		if n, ok := arg(0).(api.Node); ok {
+			return []interface{}{n.Type()}, nil
+		}
+		return nil, nil
+	case "properties":
+		if ent, ok := arg(0).(api.Entity); ok {
+			props, err := ent.Properties()
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "")
+			}
+			return normalize(props), nil
+		}
+		return arg(0), nil
+	case "tolower":
+		if arg(0) == nil {
+			return nil, nil
+		}
+		return strings.ToLower(cast.ToString(arg(0))), nil
+	case "toupper":
+		if arg(0) == nil {
+			return nil, nil
+		}
+		return strings.ToUpper(cast.ToString(arg(0))), nil
+	case "tostring":
+		if arg(0) == nil {
+			return nil, nil
+		}
+		return cast.ToString(arg(0)), nil
+	case "size", "length":
+		switch val := arg(0).(type) {
+		case string:
+			return int64(len([]rune(val))), nil
+		case []interface{}:
+			return int64(len(val)), nil
+		case []api.Relation:
+			return int64(len(val)), nil
+		}
+		return nil, nil
+	case "coalesce":
+		for _, a := range args {
+			if a != nil {
+				return a, nil
+			}
+		}
+		return nil, nil
+	}
+	return nil, stacktrace.NewError("cypher: unknown function %s", name)
+}
+
+// normalize converts decoded property values to the kinds used by expressions: int64, float64, string, bool,
+// time.Time, []interface{} & map[string]interface{}
+func normalize(val interface{}) interface{} {
+	switch v := val.(type) {
+	case nil, bool, string, int64, float64, time.Time, api.Node, api.Relation, []api.Relation:
+		return v
+	case int, int8, int16, int32, uint, uint8, uint16, uint32, uint64:
+		return cast.ToInt64(v)
+	case float32:
+		return float64(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case primitive.DateTime:
+		return v.Time()
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[k] = normalize(item)
+		}
+		return m
+	}
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			list[i] = normalize(rv.Index(i).Interface())
+		}
+		return list
+	case reflect.Map:
+		m := map[string]interface{}{}
+		for _, key := range rv.MapKeys() {
+			m[cast.ToString(key.Interface())] = normalize(rv.MapIndex(key).Interface())
+		}
+		return m
+	}
+	return val
+}
+
+func isNumber(val interface{}) bool {
+	switch val.(type) {
+	case int64, float64:
+		return true
+	}
+	return false
+}
+
+// equal returns whether two values are equal or nil if either is null
+func equal(left, right interface{}) interface{} {
+	if left == nil || right == nil {
+		return nil
+	}
+	if isNumber(left) && isNumber(right) {
+		return cast.ToFloat64(left) == cast.ToFloat64(right)
+	}
+	switch l := left.(type) {
+	case api.Entity:
+		r, ok := right.(api.Entity)
+		return ok && entityKey(l) == entityKey(r)
+	case []interface{}:
+		r, ok := right.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for i := range l {
+			eq := equal(l[i], r[i])
+			if eq == nil {
+				return nil
+			}
+			if !eq.(bool) {
+				return false
+			}
+		}
+		return true
+	case time.Time:
+		r, ok := right.(time.Time)
+		return ok && l.Equal(r)
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+// compare orders two values of the same kind. ok is false if they can't be compared.
+func compare(left, right interface{}) (int, bool) {
+	switch {
+	case isNumber(left) && isNumber(right):
+		l, r := cast.ToFloat64(left), cast.ToFloat64(right)
+		switch {
+		case l < r:
+			return -1, true
+		case l > r:
+			return 1, true
+		}
+		return 0, true
+	}
+	switch l := left.(type) {
+	case string:
+		if r, ok := right.(string); ok {
+			return strings.Compare(l, r), true
+		}
+	case bool:
+		if r, ok := right.(bool); ok {
+			switch {
+			case l == r:
+				return 0, true
+			case !l:
+				return -1, true
+			}
+			return 1, true
+		}
+	case time.Time:
+		if r, ok := right.(time.Time); ok {
+			switch {
+			case l.Before(r):
+				return -1, true
+			case l.After(r):
+				return 1, true
+			}
+			return 0, true
+		}
+	case api.Entity:
+		if r, ok := right.(api.Entity); ok {
+			return strings.Compare(entityKey(l), entityKey(r)), true
+		}
+	}
+	return 0, false
+}
+
+// order is a total order over values used by ORDER BY. Values of different kinds are ordered by kind & nulls are last.
+func order(left, right interface{}) int {
+	if cmp, ok := compare(left, right); ok {
+		return cmp
+	}
+	lk, rk := kindOrder(left), kindOrder(right)
+	if lk != rk {
+		return lk - rk
+	}
+	return strings.Compare(fmt.Sprint(left), fmt.Sprint(right))
+}
+
+func kindOrder(val interface{}) int {
+	switch val.(type) {
+	case api.Node:
+		return 0
+	case api.Relation:
+		return 1
+	case []interface{}, []api.Relation:
+		return 2
+	case map[string]interface{}:
+		return 3
+	case string:
+		return 4
+	case bool:
+		return 5
+	case int64, float64:
+		return 6
+	case time.Time:
+		return 7
+	case nil:
+		return 9
+	}
+	return 8
+}
+
+func entityKey(ent api.Entity) string {
+	return fmt.Sprintf("%s,%s", ent.Type(), ent.ID())
+}
+
+// output converts a value to the value returned to clients. Nodes & relationships are returned as their properties.
+func output(val interface{}) (interface{}, error) {
+	switch val := val.(type) {
+	case api.Entity:
+		props, err := val.Properties()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		return props, nil
+	case []api.Relation:
+		list := make([]interface{}, len(val))
+		for i, rel := range val {
+			v, err := output(rel)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = v
+		}
+		return list, nil
+	case []interface{}:
+		list := make([]interface{}, len(val))
+		for i, item := range val {
+			v, err := output(item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = v
+		}
+		return list, nil
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			v, err := output(item)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = v
+		}
+		return m, nil
+	}
+	return val, nil
+}
+
+// valueKey returns a key that's equal for equal values so that values can be grouped & deduplicated
+func valueKey(val interface{}) string {
+	switch val := val.(type) {
+	case api.Entity:
+		return "e:" + entityKey(val)
+	case []api.Relation:
+		var keys []string
+		for _, rel := range val {
+			keys = append(keys, entityKey(rel))
+		}
+		return "p:" + strings.Join(keys, "|")
+	case []interface{}:
+		var keys []string
+		for _, item := range val {
+			keys = append(keys, valueKey(item))
+		}
+		return "l:[" + strings.Join(keys, ",") + "]"
+	case map[string]interface{}:
+		var keys []string
+		for k, item := range val {
+			keys = append(keys, k+"="+valueKey(item))
+		}
+		sort.Strings(keys)
+		return "m:{" + strings.Join(keys, ",") + "}"
+	case int64, float64:
+		return fmt.Sprintf("n:%v", cast.ToFloat64(val))
+	}
+	return fmt.Sprintf("%T:%v", val, val)
+}
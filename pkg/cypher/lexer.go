@@ -0,0 +1,147 @@
+package cypher
+
+import (
+	"github.com/palantir/stacktrace"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenParam
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	// pos & end are the offsets of the token in the query
+	pos int
+	end int
+}
+
+// symbols are matched longest first
+var symbols = []string{"<>", "<=", ">=", "..", "(", ")", "[", "]", "{", "}", ":", ",", ".", "-", "<", ">", "=", "*", "|", "+", "/", "%", ";"}
+
+func lex(query string) ([]token, error) {
+	var (
+		tokens []token
+		runes  = []rune(query)
+		i      int
+	)
+	// offsets are tracked in bytes so that tokens can be sliced out of the query
+	offset := func(i int) int {
+		return len(string(runes[:i]))
+	}
+	for i < len(runes) {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '/':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '\'' || r == '"':
+			start := i
+			var sb strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+					switch runes[i] {
+					case 'n':
+						sb.WriteRune('\n')
+					case 't':
+						sb.WriteRune('\t')
+					default:
+						sb.WriteRune(runes[i])
+					}
+					continue
+				}
+				sb.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, stacktrace.NewError("cypher: unterminated string at position %v", offset(start))
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, text: sb.String(), pos: offset(start), end: offset(i)})
+		case r == '`':
+			start := i
+			i++
+			for i < len(runes) && runes[i] != '`' {
+				i++
+			}
+			if i >= len(runes) {
+				return nil, stacktrace.NewError("cypher: unterminated identifier at position %v", offset(start))
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start+1 : i-1]), pos: offset(start), end: offset(i)})
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+			// a single dot followed by a digit is a decimal point, two dots are a range
+			if i+1 < len(runes) && runes[i] == '.' && unicode.IsDigit(runes[i+1]) {
+				i++
+				for i < len(runes) && unicode.IsDigit(runes[i]) {
+					i++
+				}
+			}
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '-' || runes[j] == '+') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					i = j
+					for i < len(runes) && unicode.IsDigit(runes[i]) {
+						i++
+					}
+				}
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(runes[start:i]), pos: offset(start), end: offset(i)})
+		case r == '$':
+			start := i
+			i++
+			for i < len(runes) && isIdentRune(runes[i]) {
+				i++
+			}
+			if i == start+1 {
+				return nil, stacktrace.NewError("cypher: expected a parameter name at position %v", offset(start))
+			}
+			tokens = append(tokens, token{kind: tokenParam, text: string(runes[start+1 : i]), pos: offset(start), end: offset(i)})
+		case isIdentRune(r):
+			start := i
+			for i < len(runes) && isIdentRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: offset(start), end: offset(i)})
+		default:
+			matched := false
+			for _, symbol := range symbols {
+				if strings.HasPrefix(string(runes[i:]), symbol) {
+					tokens = append(tokens, token{kind: tokenSymbol, text: symbol, pos: offset(i), end: offset(i + len([]rune(symbol)))})
+					i += len([]rune(symbol))
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, stacktrace.NewError("cypher: unexpected character %q at position %v", string(r), offset(i))
+			}
+		}
+	}
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(query), end: len(query)})
+	return tokens, nil
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
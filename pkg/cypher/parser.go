@@ -0,0 +1,623 @@
+package cypher
+
+import (
+	"fmt"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/palantir/stacktrace"
+	"strconv"
+	"strings"
+)
+
+type parser struct {
+	query  string
+	tokens []token
+	pos    int
+	// anonymous counts the variables given to anonymous nodes & relationships
+	anonymous int
+}
+
+// Parse parses a query written in the supported subset of openCypher
+func Parse(query string) (*Statement, error) {
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	p := &parser{query: query, tokens: tokens}
+	stmt, err := p.statement()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return stmt, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	t := p.peek()
+	got := t.text
+	if t.kind == tokenEOF {
+		got = "end of query"
+	}
+	return stacktrace.NewError("cypher: %s at position %v, got %q", fmt.Sprintf(format, args...), t.pos, got)
+}
+
+// isKeyword returns whether the next token is the keyword
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenIdent && strings.EqualFold(t.text, keyword)
+}
+
+func (p *parser) acceptKeyword(keywords ...string) bool {
+	for i, keyword := range keywords {
+		if p.pos+i >= len(p.tokens) {
+			return false
+		}
+		t := p.tokens[p.pos+i]
+		if t.kind != tokenIdent || !strings.EqualFold(t.text, keyword) {
+			return false
+		}
+	}
+	p.pos += len(keywords)
+	return true
+}
+
+func (p *parser) expectKeyword(keywords ...string) error {
+	if !p.acceptKeyword(keywords...) {
+		return p.errorf("expected %s", strings.Join(keywords, " "))
+	}
+	return nil
+}
+
+func (p *parser) isSymbol(symbol string) bool {
+	t := p.peek()
+	return t.kind == tokenSymbol && t.text == symbol
+}
+
+func (p *parser) acceptSymbol(symbol string) bool {
+	if p.isSymbol(symbol) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectSymbol(symbol string) error {
+	if !p.acceptSymbol(symbol) {
+		return p.errorf("expected %q", symbol)
+	}
+	return nil
+}
+
+func (p *parser) ident() (string, error) {
+	t := p.peek()
+	if t.kind != tokenIdent {
+		return "", p.errorf("expected an identifier")
+	}
+	p.pos++
+	return t.text, nil
+}
+
+func (p *parser) anonymousVar() string {
+	p.anonymous++
+	return fmt.Sprintf("#anon%v", p.anonymous)
+}
+
+func (p *parser) statement() (*Statement, error) {
+	stmt := &Statement{}
+	if !p.isKeyword("MATCH") {
+		return nil, p.errorf("expected MATCH")
+	}
+	for p.acceptKeyword("MATCH") {
+		for {
+			pattern, err := p.pattern()
+			if err != nil {
+				return nil, err
+			}
+			stmt.Patterns = append(stmt.Patterns, pattern)
+			if !p.acceptSymbol(",") {
+				break
+			}
+		}
+		if p.acceptKeyword("WHERE") {
+			where, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			if stmt.Where == nil {
+				stmt.Where = where
+			} else {
+				stmt.Where = &Binary{Op: "AND", Left: stmt.Where, Right: where}
+			}
+		}
+	}
+	if err := p.expectKeyword("RETURN"); err != nil {
+		return nil, err
+	}
+	ret, err := p.returnClause()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Return = ret
+	if p.acceptKeyword("ORDER", "BY") {
+		for {
+			start := p.peek().pos
+			e, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			item := &SortItem{Expr: e, Text: p.text(start)}
+			switch {
+			case p.acceptKeyword("DESC"), p.acceptKeyword("DESCENDING"):
+				item.Desc = true
+			case p.acceptKeyword("ASC"), p.acceptKeyword("ASCENDING"):
+			}
+			stmt.OrderBy = append(stmt.OrderBy, item)
+			if !p.acceptSymbol(",") {
+				break
+			}
+		}
+	}
+	if p.acceptKeyword("SKIP") {
+		if stmt.Skip, err = p.expr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.acceptKeyword("LIMIT") {
+		if stmt.Limit, err = p.expr(); err != nil {
+			return nil, err
+		}
+	}
+	p.acceptSymbol(";")
+	if p.peek().kind != tokenEOF {
+		return nil, p.errorf("expected end of query")
+	}
+	return stmt, nil
+}
+
+// text returns the query text from start to the end of the last token that was consumed
+func (p *parser) text(start int) string {
+	return strings.TrimSpace(p.query[start:p.tokens[p.pos-1].end])
+}
+
+func (p *parser) returnClause() (*Return, error) {
+	ret := &Return{Distinct: p.acceptKeyword("DISTINCT")}
+	if p.acceptSymbol("*") {
+		ret.Star = true
+		if !p.acceptSymbol(",") {
+			return ret, nil
+		}
+	}
+	for {
+		start := p.peek().pos
+		e, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		item := &ReturnItem{Expr: e, Name: p.text(start)}
+		if p.acceptKeyword("AS") {
+			if item.Name, err = p.ident(); err != nil {
+				return nil, err
+			}
+		}
+		ret.Items = append(ret.Items, item)
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+	return ret, nil
+}
+
+func (p *parser) pattern() (*Pattern, error) {
+	pattern := &Pattern{}
+	node, err := p.nodePattern()
+	if err != nil {
+		return nil, err
+	}
+	pattern.Nodes = append(pattern.Nodes, node)
+	for p.isSymbol("-") || p.isSymbol("<") {
+		rel, err := p.relPattern()
+		if err != nil {
+			return nil, err
+		}
+		node, err := p.nodePattern()
+		if err != nil {
+			return nil, err
+		}
+		pattern.Rels = append(pattern.Rels, rel)
+		pattern.Nodes = append(pattern.Nodes, node)
+	}
+	return pattern, nil
+}
+
+func (p *parser) nodePattern() (*NodePattern, error) {
+	if err := p.expectSymbol("("); err != nil {
+		return nil, err
+	}
+	node := &NodePattern{}
+	if p.peek().kind == tokenIdent {
+		node.Var = p.next().text
+	} else {
+		node.Var = p.anonymousVar()
+	}
+	if p.acceptSymbol(":") {
+		label, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		node.Label = label
+		if p.isSymbol(":") {
+			return nil, p.errorf("nodes have a single label")
+		}
+	}
+	if p.isSymbol("{") {
+		props, err := p.properties()
+		if err != nil {
+			return nil, err
+		}
+		node.Props = props
+	}
+	if err := p.expectSymbol(")"); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// relPattern parses -[...]->, <-[...]-, -[...]-, -->, <-- & --
+func (p *parser) relPattern() (*RelPattern, error) {
+	rel := &RelPattern{MinHops: 1, MaxHops: 1}
+	left := p.acceptSymbol("<")
+	if err := p.expectSymbol("-"); err != nil {
+		return nil, err
+	}
+	if p.acceptSymbol("[") {
+		if p.peek().kind == tokenIdent {
+			rel.Var = p.next().text
+		}
+		if p.acceptSymbol(":") {
+			for {
+				relType, err := p.ident()
+				if err != nil {
+					return nil, err
+				}
+				rel.Types = append(rel.Types, relType)
+				if !p.acceptSymbol("|") {
+					break
+				}
+				p.acceptSymbol(":")
+			}
+		}
+		if p.acceptSymbol("*") {
+			if err := p.hops(rel); err != nil {
+				return nil, err
+			}
+		}
+		if p.isSymbol("{") {
+			props, err := p.properties()
+			if err != nil {
+				return nil, err
+			}
+			rel.Props = props
+		}
+		if err := p.expectSymbol("]"); err != nil {
+			return nil, err
+		}
+	}
+	if rel.Var == "" {
+		rel.Var = p.anonymousVar()
+	}
+	if err := p.expectSymbol("-"); err != nil {
+		return nil, err
+	}
+	right := p.acceptSymbol(">")
+	switch {
+	case left && right:
+		return nil, p.errorf("relationships have a single direction")
+	case left:
+		rel.Direction = api.Incoming
+	case right:
+		rel.Direction = api.Outgoing
+	}
+	return rel, nil
+}
+
+// hops parses the range of a variable length relationship: *, *n, *min.., *..max or *min..max
+func (p *parser) hops(rel *RelPattern) error {
+	rel.VarLength = true
+	rel.MinHops, rel.MaxHops = 1, defaultMaxHops
+	var err error
+	if p.peek().kind == tokenNumber {
+		if rel.MinHops, err = strconv.Atoi(p.next().text); err != nil {
+			return p.errorf("expected an integer")
+		}
+		rel.MaxHops = rel.MinHops
+	}
+	if p.acceptSymbol("..") {
+		rel.MaxHops = defaultMaxHops
+		if p.peek().kind == tokenNumber {
+			if rel.MaxHops, err = strconv.Atoi(p.next().text); err != nil {
+				return p.errorf("expected an integer")
+			}
+		}
+	}
+	if rel.MinHops < 0 || rel.MaxHops < rel.MinHops {
+		return p.errorf("bad relationship length %v..%v", rel.MinHops, rel.MaxHops)
+	}
+	return nil
+}
+
+func (p *parser) properties() (map[string]Expr, error) {
+	if err := p.expectSymbol("{"); err != nil {
+		return nil, err
+	}
+	props := map[string]Expr{}
+	if p.acceptSymbol("}") {
+		return props, nil
+	}
+	for {
+		key, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectSymbol(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		props[key] = value
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+	if err := p.expectSymbol("}"); err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
+func (p *parser) expr() (Expr, error) {
+	return p.binary(0)
+}
+
+// precedence lists binary operators from lowest to highest precedence
+var precedence = [][]string{
+	{"OR"},
+	{"XOR"},
+	{"AND"},
+}
+
+func (p *parser) binary(level int) (Expr, error) {
+	if level == len(precedence) {
+		return p.not()
+	}
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var op string
+		for _, candidate := range precedence[level] {
+			if p.acceptKeyword(candidate) {
+				op = candidate
+			}
+		}
+		if op == "" {
+			return left, nil
+		}
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, Left: left, Right: right}
+	}
+}
+
+func (p *parser) not() (Expr, error) {
+	if p.acceptKeyword("NOT") {
+		e, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return &Unary{Op: "NOT", Expr: e}, nil
+	}
+	return p.comparison()
+}
+
+func (p *parser) comparison() (Expr, error) {
+	left, err := p.additive()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var op string
+		switch {
+		case p.acceptSymbol("="):
+			op = "="
+		case p.acceptSymbol("<>"):
+			op = "<>"
+		case p.acceptSymbol("<="):
+			op = "<="
+		case p.acceptSymbol(">="):
+			op = ">="
+		case p.acceptSymbol("<"):
+			op = "<"
+		case p.acceptSymbol(">"):
+			op = ">"
+		case p.acceptKeyword("STARTS", "WITH"):
+			op = "STARTS WITH"
+		case p.acceptKeyword("ENDS", "WITH"):
+			op = "ENDS WITH"
+		case p.acceptKeyword("CONTAINS"):
+			op = "CONTAINS"
+		case p.acceptKeyword("IN"):
+			op = "IN"
+		case p.acceptKeyword("IS", "NOT", "NULL"):
+			left = &IsNull{Expr: left, Not: true}
+			continue
+		case p.acceptKeyword("IS", "NULL"):
+			left = &IsNull{Expr: left}
+			continue
+		default:
+			return left, nil
+		}
+		right, err := p.additive()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, Left: left, Right: right}
+	}
+}
+
+func (p *parser) additive() (Expr, error) {
+	left, err := p.multiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for p.isSymbol("+") || p.isSymbol("-") {
+		op := p.next().text
+		right, err := p.multiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) multiplicative() (Expr, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isSymbol("*") || p.isSymbol("/") || p.isSymbol("%") {
+		op := p.next().text
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) unary() (Expr, error) {
+	if p.acceptSymbol("-") {
+		e, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &Unary{Op: "-", Expr: e}, nil
+	}
+	e, err := p.atom()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptSymbol(".") {
+		key, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		e = &Property{Expr: e, Key: key}
+	}
+	return e, nil
+}
+
+func (p *parser) atom() (Expr, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokenString:
+		p.next()
+		return &Literal{Value: t.text}, nil
+	case tokenNumber:
+		p.next()
+		if i, err := strconv.ParseInt(t.text, 10, 64); err == nil {
+			return &Literal{Value: i}, nil
+		}
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, stacktrace.NewError("cypher: bad number %q at position %v", t.text, t.pos)
+		}
+		return &Literal{Value: f}, nil
+	case tokenParam:
+		p.next()
+		return &Param{Name: t.text}, nil
+	case tokenSymbol:
+		switch t.text {
+		case "(":
+			p.next()
+			e, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectSymbol(")"); err != nil {
+				return nil, err
+			}
+			return e, nil
+		case "[":
+			p.next()
+			list := &List{}
+			if p.acceptSymbol("]") {
+				return list, nil
+			}
+			for {
+				e, err := p.expr()
+				if err != nil {
+					return nil, err
+				}
+				list.Items = append(list.Items, e)
+				if !p.acceptSymbol(",") {
+					break
+				}
+			}
+			if err := p.expectSymbol("]"); err != nil {
+				return nil, err
+			}
+			return list, nil
+		}
+	case tokenIdent:
+		switch {
+		case p.acceptKeyword("TRUE"):
+			return &Literal{Value: true}, nil
+		case p.acceptKeyword("FALSE"):
+			return &Literal{Value: false}, nil
+		case p.acceptKeyword("NULL"):
+			return &Literal{Value: nil}, nil
+		}
+		p.next()
+		if !p.acceptSymbol("(") {
+			return &Variable{Name: t.text}, nil
+		}
+		call := &Call{Name: strings.ToLower(t.text)}
+		if p.acceptSymbol("*") {
+			call.Star = true
+		} else {
+			call.Distinct = p.acceptKeyword("DISTINCT")
+			for !p.isSymbol(")") {
+				e, err := p.expr()
+				if err != nil {
+					return nil, err
+				}
+				call.Args = append(call.Args, e)
+				if !p.acceptSymbol(",") {
+					break
+				}
+			}
+		}
+		if err := p.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+		return call, nil
+	}
+	return nil, p.errorf("expected an expression")
+}
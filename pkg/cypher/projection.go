@@ -0,0 +1,364 @@
+package cypher
+
+import (
+	"github.com/palantir/stacktrace"
+	"github.com/spf13/cast"
+	"sort"
+	"strings"
+)
+
+// projection computes the returned columns of the rows that match the query
+type projection struct {
+	e         *executor
+	items     []*ReturnItem
+	columns   []string
+	aggregate bool
+	skip      int
+	limit     int
+	// stopAt is the number of rows after which matching stops, or -1 if every row is needed
+	stopAt int
+	rows   []*projected
+	seen   map[string]bool
+	groups map[string]*group
+	// order is the order that groups were first seen in
+	order []string
+}
+
+type projected struct {
+	values []interface{}
+	// scope binds the variables of the row & the names of the columns for ORDER BY
+	scope row
+}
+
+type group struct {
+	values      []interface{}
+	scope       row
+	aggregators []*aggregator
+}
+
+type aggregator struct {
+	call   *Call
+	count  int64
+	sum    float64
+	intSum int64
+	floats bool
+	min    interface{}
+	max    interface{}
+	list   []interface{}
+	seen   map[string]bool
+}
+
+func (e *executor) newProjection() (*projection, error) {
+	p := &projection{
+		e:      e,
+		seen:   map[string]bool{},
+		groups: map[string]*group{},
+	}
+	if e.stmt.Return.Star {
+		for _, name := range e.variables() {
+			p.items = append(p.items, &ReturnItem{Expr: &Variable{Name: name}, Name: name})
+		}
+	}
+	p.items = append(p.items, e.stmt.Return.Items...)
+	if len(p.items) == 0 {
+		return nil, stacktrace.NewError("cypher: RETURN * requires a named variable")
+	}
+	for _, item := range p.items {
+		if containsAggregate(item.Expr) {
+			return nil, stacktrace.NewError("cypher: aggregate functions are only supported as returned columns: %s", item.Name)
+		}
+		if isAggregate(item.Expr) {
+			p.aggregate = true
+		}
+		p.columns = append(p.columns, item.Name)
+	}
+	var err error
+	if p.skip, err = e.intParam(e.stmt.Skip, "SKIP"); err != nil {
+		return nil, err
+	}
+	if p.skip < 0 {
+		p.skip = 0
+	}
+	if p.limit, err = e.intParam(e.stmt.Limit, "LIMIT"); err != nil {
+		return nil, err
+	}
+	p.stopAt = -1
+	if !p.aggregate && len(e.stmt.OrderBy) == 0 && p.limit >= 0 {
+		p.stopAt = p.skip + p.limit
+	}
+	return p, nil
+}
+
+func (p *projection) add(r row) (bool, error) {
+	if p.aggregate {
+		return true, p.addToGroup(r)
+	}
+	if p.stopAt == 0 {
+		return false, nil
+	}
+	values := make([]interface{}, len(p.items))
+	for i, item := range p.items {
+		val, err := p.e.eval(item.Expr, r)
+		if err != nil {
+			return false, err
+		}
+		values[i] = val
+	}
+	if p.e.stmt.Return.Distinct {
+		key := rowKey(values)
+		if p.seen[key] {
+			return true, nil
+		}
+		p.seen[key] = true
+	}
+	p.rows = append(p.rows, &projected{values: values, scope: p.scope(r, values)})
+	return p.stopAt < 0 || len(p.rows) < p.stopAt, nil
+}
+
+func (p *projection) addToGroup(r row) error {
+	var (
+		values = make([]interface{}, len(p.items))
+		keys   []interface{}
+	)
+	for i, item := range p.items {
+		if isAggregate(item.Expr) {
+			continue
+		}
+		val, err := p.e.eval(item.Expr, r)
+		if err != nil {
+			return err
+		}
+		values[i] = val
+		keys = append(keys, val)
+	}
+	key := rowKey(keys)
+	g, ok := p.groups[key]
+	if !ok {
+		g = &group{values: values, scope: r, aggregators: make([]*aggregator, len(p.items))}
+		for i, item := range p.items {
+			if call, ok := item.Expr.(*Call); ok && isAggregate(call) {
+				g.aggregators[i] = &aggregator{call: call, seen: map[string]bool{}}
+			}
+		}
+		p.groups[key] = g
+		p.order = append(p.order, key)
+	}
+	for _, agg := range g.aggregators {
+		if agg == nil {
+			continue
+		}
+		if err := agg.add(p.e, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *projection) scope(r row, values []interface{}) row {
+	scope := make(row, len(r)+len(values))
+	for k, v := range r {
+		scope[k] = v
+	}
+	for i, name := range p.columns {
+		scope[name] = values[i]
+	}
+	return scope
+}
+
+func (p *projection) result() (*Result, error) {
+	if p.aggregate {
+		// aggregating no rows without grouping keys returns a single row, e.g. count(*) = 0
+		if len(p.order) == 0 && p.groupless() {
+			if err := p.addToGroup(row{}); err != nil {
+				return nil, err
+			}
+			for _, agg := range p.groups[p.order[0]].aggregators {
+				if agg != nil {
+					*agg = aggregator{call: agg.call, seen: map[string]bool{}}
+				}
+			}
+		}
+		for _, key := range p.order {
+			g := p.groups[key]
+			values := make([]interface{}, len(p.items))
+			for i, agg := range g.aggregators {
+				if agg == nil {
+					values[i] = g.values[i]
+					continue
+				}
+				values[i] = agg.result()
+			}
+			p.rows = append(p.rows, &projected{values: values, scope: p.scope(g.scope, values)})
+		}
+	}
+	if err := p.sort(); err != nil {
+		return nil, err
+	}
+	rows := p.rows
+	if p.skip >= len(rows) {
+		rows = nil
+	} else {
+		rows = rows[p.skip:]
+	}
+	if p.limit >= 0 && p.limit < len(rows) {
+		rows = rows[:p.limit]
+	}
+	result := &Result{Columns: p.columns, Rows: [][]interface{}{}}
+	for _, r := range rows {
+		values := make([]interface{}, len(r.values))
+		for i, val := range r.values {
+			out, err := output(val)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = out
+		}
+		result.Rows = append(result.Rows, values)
+	}
+	return result, nil
+}
+
+// groupless returns whether every returned column is an aggregate
+func (p *projection) groupless() bool {
+	for _, item := range p.items {
+		if !isAggregate(item.Expr) {
+			return false
+		}
+	}
+	return true
+}
+
+// sort orders the rows by the ORDER BY clause. Sort items that match the name of a returned column are sorted by the
+// column, otherwise they're evaluated against the row.
+func (p *projection) sort() error {
+	if len(p.e.stmt.OrderBy) == 0 {
+		return nil
+	}
+	keys := make([][]interface{}, len(p.rows))
+	for i, r := range p.rows {
+		for _, item := range p.e.stmt.OrderBy {
+			var (
+				val   interface{}
+				found bool
+			)
+			for j, name := range p.columns {
+				if name == item.Text {
+					val, found = r.values[j], true
+					break
+				}
+			}
+			if !found {
+				var err error
+				if val, err = p.e.eval(item.Expr, r.scope); err != nil {
+					return err
+				}
+			}
+			keys[i] = append(keys[i], val)
+		}
+	}
+	indexes := make([]int, len(p.rows))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		for k, item := range p.e.stmt.OrderBy {
+			cmp := order(keys[indexes[a]][k], keys[indexes[b]][k])
+			if cmp == 0 {
+				continue
+			}
+			if item.Desc {
+				return cmp > 0
+			}
+			return cmp < 0
+		}
+		return false
+	})
+	rows := make([]*projected, len(p.rows))
+	for i, index := range indexes {
+		rows[i] = p.rows[index]
+	}
+	p.rows = rows
+	return nil
+}
+
+func (a *aggregator) add(e *executor, r row) error {
+	if a.call.Star {
+		a.count++
+		return nil
+	}
+	if len(a.call.Args) != 1 {
+		return stacktrace.NewError("cypher: %s expects a single argument", a.call.Name)
+	}
+	val, err := e.eval(a.call.Args[0], r)
+	if err != nil {
+		return err
+	}
+	if val == nil {
+		return nil
+	}
+	if a.call.Distinct {
+		key := valueKey(val)
+		if a.seen[key] {
+			return nil
+		}
+		a.seen[key] = true
+	}
+	a.count++
+	switch a.call.Name {
+	case "sum", "avg":
+		switch v := val.(type) {
+		case int64:
+			a.intSum += v
+		case float64:
+			a.floats = true
+		default:
+			return stacktrace.NewError("cypher: %s expects numbers, got %v", a.call.Name, val)
+		}
+		a.sum += cast.ToFloat64(val)
+	case "min":
+		if a.min == nil || order(val, a.min) < 0 {
+			a.min = val
+		}
+	case "max":
+		if a.max == nil || order(val, a.max) > 0 {
+			a.max = val
+		}
+	case "collect":
+		a.list = append(a.list, val)
+	}
+	return nil
+}
+
+func (a *aggregator) result() interface{} {
+	switch a.call.Name {
+	case "count":
+		return a.count
+	case "sum":
+		if a.floats {
+			return a.sum
+		}
+		return a.intSum
+	case "avg":
+		if a.count == 0 {
+			return nil
+		}
+		return a.sum / float64(a.count)
+	case "min":
+		return a.min
+	case "max":
+		return a.max
+	default:
+		if a.list == nil {
+			return []interface{}{}
+		}
+		return a.list
+	}
+}
+
+func rowKey(values []interface{}) string {
+	keys := make([]string, len(values))
+	for i, val := range values {
+		keys[i] = valueKey(val)
+	}
+	return strings.Join(keys, "\x00")
+}
@@ -0,0 +1,120 @@
+package graph
+
+import (
+	"context"
+	"github.com/autom8ter/morpheus/pkg/analytics"
+	"github.com/autom8ter/morpheus/pkg/graph/fsm"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/palantir/stacktrace"
+	"time"
+)
+
+const (
+	defaultAnalyticsLimit = 100
+	// writeBatchSize is the maximum number of nodes written back by a single raft command
+	writeBatchSize = 1000
+)
+
+func analyticsOptions(nodeTypes, relationTypes []string) analytics.Options {
+	return analytics.Options{
+		NodeTypes:     nodeTypes,
+		RelationTypes: relationTypes,
+	}
+}
+
+func analyticsLimit(limit *int) int {
+	if limit == nil || *limit <= 0 {
+		return defaultAnalyticsLimit
+	}
+	return *limit
+}
+
+func (r *Resolver) toNodeScores(scores []analytics.Score, limit *int) ([]*model.NodeScore, error) {
+	var resp []*model.NodeScore
+	for i, score := range scores {
+		if i >= analyticsLimit(limit) {
+			break
+		}
+		n, err := r.graph.GetNode(score.Key.Type, score.Key.ID)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		node, err := toNode(n)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		resp = append(resp, &model.NodeScore{Node: node, Score: score.Score})
+	}
+	return resp, nil
+}
+
+func (r *Resolver) toNodeGroups(groups []analytics.Group, limit *int) ([]*model.NodeGroup, error) {
+	var resp []*model.NodeGroup
+	for i, group := range groups {
+		if i >= analyticsLimit(limit) {
+			break
+		}
+		n, err := r.graph.GetNode(group.Key.Type, group.Key.ID)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		node, err := toNode(n)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		resp = append(resp, &model.NodeGroup{Node: node, Group: group.Group})
+	}
+	return resp, nil
+}
+
+// writeProperty sets the property of every node to its value through raft so that the results of an algorithm are
+// replicated. Other properties of the nodes are left as they are.
+func (r *Resolver) writeProperty(ctx context.Context, property string, values map[model.Key]interface{}) error {
+	if property == "" {
+		return stacktrace.NewError("empty write property")
+	}
+	var batch []*model.SetNode
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := r.applyCMD(ctx, &fsm.CMD{
+			Method:    fsm.MethodBulkMerge,
+			SetNodes:  batch,
+			Timestamp: time.Now(),
+		}); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		batch = nil
+		return nil
+	}
+	for key, value := range values {
+		batch = append(batch, &model.SetNode{
+			Type:       key.Type,
+			ID:         key.ID,
+			Properties: map[string]interface{}{property: value},
+		})
+		if len(batch) >= writeBatchSize {
+			if err := flush(); err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		}
+	}
+	return flush()
+}
+
+func scoreValues(scores []analytics.Score) map[model.Key]interface{} {
+	values := map[model.Key]interface{}{}
+	for _, score := range scores {
+		values[score.Key] = score.Score
+	}
+	return values
+}
+
+func groupValues(groups []analytics.Group) map[model.Key]interface{} {
+	values := map[model.Key]interface{}{}
+	for _, group := range groups {
+		values[group.Key] = group.Group
+	}
+	return values
+}
@@ -0,0 +1,322 @@
+package graph
+
+import (
+	"context"
+	"github.com/autom8ter/morpheus/pkg/config"
+	"github.com/autom8ter/morpheus/pkg/constants"
+	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/autom8ter/morpheus/pkg/graph/fsm"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/autom8ter/morpheus/pkg/logger"
+	"github.com/autom8ter/morpheus/pkg/middleware"
+	"github.com/autom8ter/morpheus/pkg/raft"
+	"github.com/palantir/stacktrace"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// MembershipPath is the path that cluster members accept membership changes on. Changes received by followers are
+// forwarded to the leader.
+const MembershipPath = "/raft/membership"
+
+type membershipOp string
+
+const (
+	membershipJoin     membershipOp = "join"
+	membershipRemove   membershipOp = "remove"
+	membershipTransfer membershipOp = "transfer_leadership"
+)
+
+type membershipChange struct {
+	Op      membershipOp `json:"op"`
+	ID      string       `json:"id"`
+	Address string       `json:"address"`
+}
+
+// changeMembership applies the membership change on the leader, forwarding it if the node is a follower. Changes are
+// retried while there is no leader.
+func (r *Resolver) changeMembership(ctx context.Context, change *membershipChange) error {
+	bits, err := encode.Marshal(change)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * applyRetryInterval)
+		}
+		if r.raft.IsLeader() {
+			err := r.applyMembership(change)
+			if err == nil {
+				return nil
+			}
+			if stacktrace.GetCode(err) != http.StatusServiceUnavailable || attempt == applyRetries {
+				return stacktrace.Propagate(err, "")
+			}
+			continue
+		}
+		leader := r.raft.LeaderAddr()
+		if leader == "" {
+			if attempt == applyRetries {
+				return stacktrace.Propagate(constants.ErrUnavailable, "no raft leader after %v attempts", attempt+1)
+			}
+			continue
+		}
+		if _, err := r.callMember(ctx, leader, MembershipPath, bits); err != nil {
+			if stacktrace.GetCode(err) != http.StatusServiceUnavailable || attempt == applyRetries {
+				return stacktrace.Propagate(err, "")
+			}
+			continue
+		}
+		return nil
+	}
+}
+
+func (r *Resolver) applyMembership(change *membershipChange) error {
+	var err error
+	switch change.Op {
+	case membershipJoin:
+		if change.ID == "" || change.Address == "" {
+			return stacktrace.NewErrorWithCode(http.StatusBadRequest, "join requires an id & address")
+		}
+		err = r.raft.Join(change.ID, change.Address)
+	case membershipRemove:
+		if change.ID == "" {
+			return stacktrace.NewErrorWithCode(http.StatusBadRequest, "remove requires an id")
+		}
+		err = r.raft.Remove(change.ID)
+	case membershipTransfer:
+		err = r.raft.TransferLeadership(change.ID, change.Address)
+	default:
+		return stacktrace.NewErrorWithCode(http.StatusBadRequest, "unsupported membership change: %s", change.Op)
+	}
+	if err != nil {
+		if leadershipChanged(err) {
+			return stacktrace.PropagateWithCode(err, http.StatusServiceUnavailable, "")
+		}
+		return stacktrace.Propagate(err, "")
+	}
+	logger.L.Info("changed raft cluster membership", map[string]interface{}{
+		"op":      change.Op,
+		"id":      change.ID,
+		"address": change.Address,
+	})
+	return nil
+}
+
+// authorizeMembership checks that the caller may make the membership change. Changes made for a user require the ADMIN
+// role, while members that aren't acting for a user may only join the cluster, which is how discovered members join.
+func (r *Resolver) authorizeMembership(ctx context.Context, change *membershipChange) error {
+	if _, ok := middleware.GetTokenCtx(ctx); !ok && change.Op == membershipJoin {
+		return nil
+	}
+	if _, err := r.mw.RequireRole(ctx, config.ADMIN); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return nil
+}
+
+// MembershipHandler applies membership changes sent by other members of the cluster
+func (r *Resolver) MembershipHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		bits, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			writeResult(w, &fsm.Result{Code: http.StatusBadRequest, Error: err.Error()})
+			return
+		}
+		change := &membershipChange{}
+		if err := encode.Unmarshal(bits, change); err != nil {
+			writeResult(w, &fsm.Result{Code: http.StatusBadRequest, Error: err.Error()})
+			return
+		}
+		if err := r.authorizeMembership(req.Context(), change); err != nil {
+			writeError(w, err)
+			return
+		}
+		if err := r.changeMembership(req.Context(), change); err != nil {
+			writeError(w, err)
+			return
+		}
+		writeResult(w, &fsm.Result{Code: http.StatusOK, Ok: true})
+	})
+}
+
+// PeerPath is the path that cluster members serve their peer id & raft address on for discovery
+const PeerPath = "/raft/peer"
+
+type peerInfo struct {
+	ID           string `json:"id"`
+	Address      string `json:"address"`
+	Bootstrapped bool   `json:"bootstrapped"`
+	Leader       string `json:"leader"`
+}
+
+// PeerHandler serves the peer id & raft address of the node to discovered peers
+func (r *Resolver) PeerHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := r.peerInfo()
+		if err != nil {
+			writeResult(w, &fsm.Result{Code: http.StatusInternalServerError, Error: err.Error()})
+			return
+		}
+		bits, err := encode.Marshal(info)
+		if err != nil {
+			writeResult(w, &fsm.Result{Code: http.StatusInternalServerError, Error: err.Error()})
+			return
+		}
+		writeResult(w, &fsm.Result{Code: http.StatusOK, Ok: true, Data: bits})
+	})
+}
+
+func (r *Resolver) peerInfo() (*peerInfo, error) {
+	bootstrapped, err := r.raft.Bootstrapped()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &peerInfo{
+		ID:           r.raft.PeerID(),
+		Address:      r.raft.LocalAddr(),
+		Bootstrapped: bootstrapped,
+		Leader:       r.raft.LeaderAddr(),
+	}, nil
+}
+
+// DiscoverCluster adds the node to a cluster, polling the discovered peers until it's a member. If one of the peers
+// is a member of a cluster with a leader, the node asks it to join the cluster. If none of the peers are members of a
+// cluster & exactly expect servers (including the node) have been discovered, the server with the lowest id
+// bootstraps a new cluster with all of them, which the others become members of. If expect is 0, the node only ever
+// joins an existing cluster.
+func (r *Resolver) DiscoverCluster(ctx context.Context, discovery raft.Discovery, expect int) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		joined, err := r.discover(ctx, discovery, expect)
+		if err != nil {
+			logger.L.Warn("raft cluster discovery failed - retrying", map[string]interface{}{
+				"error": stacktrace.RootCause(err).Error(),
+			})
+		}
+		if joined {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return stacktrace.Propagate(ctx.Err(), "")
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Resolver) discover(ctx context.Context, discovery raft.Discovery, expect int) (bool, error) {
+	self, err := r.peerInfo()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	if self.Bootstrapped {
+		return true, nil
+	}
+	addrs, err := discovery.Peers(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	var (
+		servers      = map[string]string{self.ID: self.Address}
+		leaders      []string
+		bootstrapped bool
+	)
+	for _, addr := range addrs {
+		if addr == self.Address {
+			continue
+		}
+		result, err := r.callMember(ctx, addr, PeerPath, nil)
+		if err != nil {
+			logger.L.Debug("failed to reach discovered peer", map[string]interface{}{
+				"peer":  addr,
+				"error": stacktrace.RootCause(err).Error(),
+			})
+			continue
+		}
+		peer := &peerInfo{}
+		if err := encode.Unmarshal(result.Data, peer); err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+		if peer.ID == self.ID {
+			continue
+		}
+		servers[peer.ID] = peer.Address
+		if peer.Bootstrapped {
+			bootstrapped = true
+			if peer.Leader != "" {
+				leaders = append(leaders, addr)
+			}
+		}
+	}
+	if len(leaders) > 0 {
+		bits, err := encode.Marshal(&membershipChange{
+			Op:      membershipJoin,
+			ID:      self.ID,
+			Address: self.Address,
+		})
+		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+		if _, err := r.callMember(ctx, leaders[0], MembershipPath, bits); err != nil {
+			return false, stacktrace.Propagate(err, "failed to join cluster via %s", leaders[0])
+		}
+		logger.L.Info("joined raft cluster", map[string]interface{}{
+			"peer": leaders[0],
+			"id":   self.ID,
+		})
+		return true, nil
+	}
+	// members of a cluster without a leader may be electing one, so the node waits to join it rather than bootstrapping
+	// a second cluster
+	if bootstrapped || expect < 1 || len(servers) != expect {
+		if expect > 0 && len(servers) > expect {
+			logger.L.Warn("discovered more raft servers than expected - waiting to join an existing cluster", map[string]interface{}{
+				"servers": servers,
+				"expect":  expect,
+			})
+		}
+		return false, nil
+	}
+	for id := range servers {
+		if id < self.ID {
+			// the server with the lowest id bootstraps the cluster
+			return false, nil
+		}
+	}
+	if err := r.raft.Bootstrap(servers); err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	logger.L.Info("bootstrapped raft cluster", map[string]interface{}{
+		"servers": servers,
+	})
+	return true, nil
+}
+
+func (r *Resolver) clusterStatus() (*model.ClusterStatus, error) {
+	servers, err := r.raft.Servers()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	leader := r.raft.LeaderAddr()
+	status := &model.ClusterStatus{
+		PeerID: r.raft.PeerID(),
+		State:  r.raft.State().String(),
+		Leader: leader,
+		Stats:  map[string]interface{}{},
+	}
+	for _, srv := range servers {
+		status.Servers = append(status.Servers, &model.ClusterServer{
+			ID:       string(srv.ID),
+			Address:  string(srv.Address),
+			Suffrage: srv.Suffrage.String(),
+			Leader:   string(srv.Address) == leader,
+		})
+	}
+	for k, v := range r.raft.Stats() {
+		status.Stats[k] = v
+	}
+	return status, nil
+}
@@ -0,0 +1,181 @@
+package graph
+
+import (
+	"context"
+	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/palantir/stacktrace"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeDiscovery struct {
+	peers []string
+}
+
+func (f *fakeDiscovery) Peers(ctx context.Context) ([]string, error) {
+	return f.peers, nil
+}
+
+func (f *fakeDiscovery) Close() error {
+	return nil
+}
+
+func serverIDs(t *testing.T, n *testNode) map[string]bool {
+	servers, err := n.raft.Servers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := map[string]bool{}
+	for _, srv := range servers {
+		ids[string(srv.ID)] = true
+	}
+	return ids
+}
+
+func TestMembership(t *testing.T) {
+	nodes := newTestCluster(t, 1)
+	leader := nodes[0]
+	joiner, other := newTestNode(t, "node-1", false), newTestNode(t, "node-2", false)
+	admin := userCtx(t, leader.resolver.mw, "admin")
+	// join on the leader
+	if err := leader.resolver.changeMembership(admin, &membershipChange{Op: membershipJoin, ID: joiner.id, Address: joiner.addr}); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "join", func() bool {
+		return joiner.raft.LeaderAddr() == leader.addr
+	})
+	// join forwarded by a follower
+	if err := joiner.resolver.changeMembership(admin, &membershipChange{Op: membershipJoin, ID: other.id, Address: other.addr}); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "forwarded join", func() bool {
+		return other.raft.LeaderAddr() == leader.addr
+	})
+	if ids := serverIDs(t, leader); len(ids) != 3 || !ids[joiner.id] || !ids[other.id] {
+		t.Fatalf("expected 3 servers, got: %v", ids)
+	}
+	// membership changes forwarded for users that aren't admins are rejected by the leader
+	for _, test := range []struct {
+		ctx  context.Context
+		code int
+	}{
+		{ctx: context.Background(), code: http.StatusUnauthorized},
+		{ctx: userCtx(t, leader.resolver.mw, "writer"), code: http.StatusForbidden},
+	} {
+		err := other.resolver.changeMembership(test.ctx, &membershipChange{Op: membershipRemove, ID: joiner.id})
+		if int(stacktrace.GetCode(err)) != test.code {
+			t.Fatalf("expected code %v, got: %v", test.code, err)
+		}
+	}
+	// members that aren't acting for a user may only join
+	bits, err := encode.Marshal(&membershipChange{Op: membershipJoin, ID: other.id, Address: other.addr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.resolver.callMember(context.Background(), leader.addr, MembershipPath, bits); err != nil {
+		t.Fatal(err)
+	}
+	// leave forwarded by the member that's leaving
+	if err := other.resolver.changeMembership(admin, &membershipChange{Op: membershipRemove, ID: other.id}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := serverIDs(t, leader); len(ids) != 2 || ids[other.id] {
+		t.Fatalf("expected %s to have left, got: %v", other.id, ids)
+	}
+	// remove on the leader
+	if err := leader.resolver.changeMembership(admin, &membershipChange{Op: membershipRemove, ID: joiner.id}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := serverIDs(t, leader); len(ids) != 1 || !ids[leader.id] {
+		t.Fatalf("expected only the leader to remain, got: %v", ids)
+	}
+	if !leader.raft.IsLeader() {
+		t.Fatal("expected the leader to remain the leader")
+	}
+}
+
+func TestDiscoverCluster(t *testing.T) {
+	var (
+		nodes = []*testNode{newTestNode(t, "node-0", false), newTestNode(t, "node-1", false), newTestNode(t, "node-2", false)}
+		addrs []string
+	)
+	for _, n := range nodes {
+		addrs = append(addrs, n.addr)
+	}
+	// nothing is bootstrapped until exactly expect servers are discovered
+	for _, test := range []struct {
+		peers  []string
+		expect int
+	}{
+		{peers: addrs[:2], expect: 3},
+		{peers: addrs, expect: 2},
+		{peers: addrs, expect: 0},
+	} {
+		for _, n := range nodes {
+			joined, err := n.resolver.discover(context.Background(), &fakeDiscovery{peers: test.peers}, test.expect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if joined {
+				t.Fatalf("%s: expected not to bootstrap %v peers with expect %v", n.id, len(test.peers), test.expect)
+			}
+		}
+	}
+	// only the server with the lowest id bootstraps the cluster
+	for _, n := range nodes[1:] {
+		if joined, err := n.resolver.discover(context.Background(), &fakeDiscovery{peers: addrs}, 3); err != nil || joined {
+			t.Fatalf("%s: expected to wait for %s to bootstrap, got: %v %v", n.id, nodes[0].id, joined, err)
+		}
+	}
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		n := n
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := n.resolver.DiscoverCluster(ctx, &fakeDiscovery{peers: addrs}, 3); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	for _, n := range nodes {
+		waitFor(t, "cluster", func() bool {
+			return n.raft.LeaderAddr() != "" && len(serverIDs(t, n)) == 3
+		})
+	}
+	// a server that discovers the cluster joins it rather than bootstrapping another
+	late := newTestNode(t, "node-3", false)
+	if err := late.resolver.DiscoverCluster(context.Background(), &fakeDiscovery{peers: append(addrs, late.addr)}, 4); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "join", func() bool {
+		return len(serverIDs(t, nodes[0])) == 4 && late.raft.LeaderAddr() == nodes[0].raft.LeaderAddr()
+	})
+}
+
+func TestDiscoverClusterWithoutLeader(t *testing.T) {
+	// a server that's bootstrapped with a peer that never starts can't elect a leader
+	peer := newTestNode(t, "node-1", false)
+	if err := peer.raft.Bootstrap(map[string]string{peer.id: peer.addr, "missing": "127.0.0.1:1"}); err != nil {
+		t.Fatal(err)
+	}
+	n := newTestNode(t, "node-0", false)
+	for i := 0; i < 3; i++ {
+		joined, err := n.resolver.discover(context.Background(), &fakeDiscovery{peers: []string{n.addr, peer.addr}}, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if joined {
+			t.Fatal("expected to wait for the cluster to elect a leader rather than bootstrap another")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if bootstrapped, err := n.raft.Bootstrapped(); err != nil || bootstrapped {
+		t.Fatalf("expected not to be bootstrapped, got: %v %v", bootstrapped, err)
+	}
+}
@@ -0,0 +1,120 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/autom8ter/morpheus/pkg/constants"
+	"github.com/autom8ter/morpheus/pkg/graph/fsm"
+	"github.com/autom8ter/morpheus/pkg/middleware"
+	"github.com/palantir/stacktrace"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+)
+
+// ReadIndexPath is the path that raft leaders serve read indexes on for linearizable reads on followers
+const ReadIndexPath = "/raft/read_index"
+
+// awaitConsistency blocks until the local store satisfies the consistency level of the request
+func (r *Resolver) awaitConsistency(ctx context.Context) error {
+	switch middleware.GetConsistencyCtx(ctx) {
+	case middleware.ConsistencyLeader:
+		if !r.raft.IsLeader() {
+			return stacktrace.Propagate(constants.ErrUnavailable, "leader reads must be served by the leader")
+		}
+	case middleware.ConsistencyLinearizable:
+		index, err := r.readIndex(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		if err := r.raft.WaitForApplied(index); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	return nil
+}
+
+// readIndex returns the read index from the leader, retrying while there is no leader
+func (r *Resolver) readIndex(ctx context.Context) (uint64, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * applyRetryInterval)
+		}
+		if r.raft.IsLeader() {
+			index, err := r.raft.ReadIndex()
+			if err == nil {
+				return index, nil
+			}
+			if !leadershipChanged(err) || attempt == applyRetries {
+				return 0, stacktrace.Propagate(err, "")
+			}
+			continue
+		}
+		leader := r.raft.LeaderAddr()
+		if leader == "" {
+			if attempt == applyRetries {
+				return 0, stacktrace.Propagate(constants.ErrUnavailable, "no raft leader after %v attempts", attempt+1)
+			}
+			continue
+		}
+		result, err := r.callMember(ctx, leader, ReadIndexPath, nil)
+		if err != nil {
+			if stacktrace.GetCode(err) != http.StatusServiceUnavailable || attempt == applyRetries {
+				return 0, stacktrace.Propagate(err, "")
+			}
+			continue
+		}
+		return result.Index, nil
+	}
+}
+
+// ReadIndexHandler serves the leader's read index to followers
+func (r *Resolver) ReadIndexHandler() http.Handler {
+	return r.leaderHandler(func(w http.ResponseWriter, req *http.Request) {
+		index, err := r.raft.ReadIndex()
+		if err != nil {
+			code := http.StatusInternalServerError
+			if leadershipChanged(err) {
+				code = http.StatusServiceUnavailable
+			}
+			writeResult(w, &fsm.Result{Code: code, Error: err.Error()})
+			return
+		}
+		writeResult(w, &fsm.Result{Index: index, Code: http.StatusOK})
+	})
+}
+
+// LeaderProxy proxies requests with LEADER consistency to the internal graphql handler of the leader when the node is a
+// follower
+func (r *Resolver) LeaderProxy(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		consistency, err := middleware.ParseConsistency(req.Header.Get(middleware.ConsistencyHeader))
+		if err != nil || consistency != middleware.ConsistencyLeader || r.raft.IsLeader() {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		// wait for an election in progress the same way commands do
+		leader := r.raft.LeaderAddr()
+		for attempt := 1; leader == "" && attempt <= applyRetries && req.Context().Err() == nil; attempt++ {
+			time.Sleep(time.Duration(attempt) * applyRetryInterval)
+			if r.raft.IsLeader() {
+				handler.ServeHTTP(w, req)
+				return
+			}
+			leader = r.raft.LeaderAddr()
+		}
+		if leader == "" {
+			http.Error(w, "no raft leader", http.StatusServiceUnavailable)
+			return
+		}
+		target, err := url.Parse(fmt.Sprintf("http://%s", leader))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		proxy := httputil.NewSingleHostReverseProxy(target)
+		proxy.Transport = r.internal.Transport
+		proxy.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,169 @@
+package graph
+
+import (
+	"context"
+	"github.com/autom8ter/morpheus/pkg/constants"
+	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/autom8ter/morpheus/pkg/graph/fsm"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/autom8ter/morpheus/pkg/middleware"
+	"github.com/palantir/stacktrace"
+	"github.com/spf13/cast"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// consistencyCtx returns the context of a request with the consistency level
+func consistencyCtx(mw *middleware.Middleware, consistency middleware.Consistency) context.Context {
+	req := httptest.NewRequest(http.MethodPost, "/query", nil)
+	req.Header.Set(middleware.ConsistencyHeader, string(consistency))
+	var ctx context.Context
+	mw.Wrap(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx = req.Context()
+	})).ServeHTTP(httptest.NewRecorder(), req)
+	return ctx
+}
+
+func mustMarshal(t *testing.T, cmd *fsm.CMD) []byte {
+	bits, err := encode.Marshal(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bits
+}
+
+func TestConsistency(t *testing.T) {
+	nodes := newTestCluster(t, 2)
+	leader, follower := nodes[0], nodes[1]
+	// STALE reads are served by the follower without waiting
+	if err := follower.resolver.awaitConsistency(consistencyCtx(follower.resolver.mw, middleware.ConsistencyStale)); err != nil {
+		t.Fatal(err)
+	}
+	// LEADER reads can't be served by the follower itself
+	err := follower.resolver.awaitConsistency(consistencyCtx(follower.resolver.mw, middleware.ConsistencyLeader))
+	if stacktrace.GetCode(err) != stacktrace.GetCode(constants.ErrUnavailable) {
+		t.Fatalf("expected leader reads to be unavailable on the follower, got: %v", err)
+	}
+	if err := leader.resolver.awaitConsistency(consistencyCtx(leader.resolver.mw, middleware.ConsistencyLeader)); err != nil {
+		t.Fatal(err)
+	}
+	// LINEARIZABLE reads wait until the follower has applied every committed command
+	for i := 0; i < 10; i++ {
+		_, index, err := leader.raft.Apply(mustMarshal(t, &fsm.CMD{
+			Method:    fsm.MethodSet,
+			Node:      model.Node{Type: "user", ID: "1", Properties: map[string]interface{}{"count": i}},
+			Timestamp: time.Now(),
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := follower.resolver.awaitConsistency(consistencyCtx(follower.resolver.mw, middleware.ConsistencyLinearizable)); err != nil {
+			t.Fatal(err)
+		}
+		n, err := follower.graph.GetNode("user", "1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count, err := n.GetProperty("count"); err != nil || cast.ToInt(count) != i {
+			t.Fatalf("expected count %v after applying %v, got: %v", i, index, count)
+		}
+	}
+	// the read index is confirmed with heartbeats, so reads don't append to the log
+	last := leader.raft.Stats()["last_log_index"]
+	for i := 0; i < 5; i++ {
+		if err := follower.resolver.awaitConsistency(consistencyCtx(follower.resolver.mw, middleware.ConsistencyLinearizable)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if actual := leader.raft.Stats()["last_log_index"]; actual != last {
+		t.Fatalf("expected linearizable reads to leave the log at %v, got: %v", last, actual)
+	}
+}
+
+func TestLeaderProxy(t *testing.T) {
+	nodes := newTestCluster(t, 2)
+	leader, follower := nodes[0], nodes[1]
+	local := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte("local"))
+	})
+	serve := func(n *testNode, consistency middleware.Consistency) string {
+		req := httptest.NewRequest(http.MethodPost, "/query", nil)
+		req.Header.Set(middleware.ConsistencyHeader, string(consistency))
+		w := httptest.NewRecorder()
+		n.resolver.LeaderProxy(local).ServeHTTP(w, req)
+		bits, err := ioutil.ReadAll(w.Result().Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(bits)
+	}
+	for _, test := range []struct {
+		node        *testNode
+		consistency middleware.Consistency
+		expected    string
+	}{
+		{node: follower, consistency: middleware.ConsistencyStale, expected: "local"},
+		{node: follower, consistency: middleware.ConsistencyLinearizable, expected: "local"},
+		// the test nodes serve their peer id on their internal graphql handler
+		{node: follower, consistency: middleware.ConsistencyLeader, expected: leader.id},
+		{node: leader, consistency: middleware.ConsistencyLeader, expected: "local"},
+	} {
+		if actual := serve(test.node, test.consistency); actual != test.expected {
+			t.Fatalf("%s read on %s: expected %q, got: %q", test.consistency, test.node.id, test.expected, actual)
+		}
+	}
+}
+
+func TestReadIndexLeaderChange(t *testing.T) {
+	nodes := newTestCluster(t, 3)
+	reader := nodes[2]
+	if _, _, err := nodes[0].raft.Apply(mustMarshal(t, &fsm.CMD{
+		Method:    fsm.MethodSet,
+		Node:      model.Node{Type: "user", ID: "1"},
+		Timestamp: time.Now(),
+	})); err != nil {
+		t.Fatal(err)
+	}
+	// linearizable reads that start while leadership moves retry against the new leader
+	var (
+		wg   sync.WaitGroup
+		errs = make(chan error, 20)
+	)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- reader.resolver.awaitConsistency(consistencyCtx(reader.resolver.mw, middleware.ConsistencyLinearizable))
+		}()
+		if i == 0 {
+			if err := nodes[0].raft.TransferLeadership(nodes[1].id, nodes[1].addr); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitFor(t, "new leader", func() bool {
+		return reader.raft.LeaderAddr() == nodes[1].addr
+	})
+	// the old leader now forwards its read index requests to the new leader
+	index, err := nodes[0].resolver.readIndex(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := nodes[0].raft.WaitForApplied(index); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := nodes[0].graph.GetNode("user", "1"); err != nil {
+		t.Fatal(err)
+	}
+}
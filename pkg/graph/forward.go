@@ -0,0 +1,270 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/config"
+	"github.com/autom8ter/morpheus/pkg/constants"
+	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/autom8ter/morpheus/pkg/graph/fsm"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/autom8ter/morpheus/pkg/logger"
+	"github.com/autom8ter/morpheus/pkg/middleware"
+	raft2 "github.com/autom8ter/morpheus/pkg/raft"
+	"github.com/hashicorp/raft"
+	"github.com/palantir/stacktrace"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const (
+	// ForwardPath is the path that raft leaders accept forwarded commands on
+	ForwardPath        = "/raft/apply"
+	applyRetries       = 5
+	applyRetryInterval = 250 * time.Millisecond
+)
+
+// internalClient returns the http client that requests are sent to other cluster members with. Requests are sent over
+// the raft rpc listener of the member, which authenticates & encrypts them the same way as raft connections.
+func internalClient(r *raft2.Raft) *http.Client {
+	return &http.Client{
+		Timeout: r.Timeout(),
+		Transport: &http.Transport{
+			DialContext: r.DialRPC,
+		},
+	}
+}
+
+// InternalHandler returns the handler of requests from other cluster members, which is served on the raft rpc listener.
+// Requests carry the credentials of the user that made them so that members can check the user's roles. query is the
+// graphql handler that LEADER consistency requests are proxied to.
+func (r *Resolver) InternalHandler(query http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/query", query)
+	mux.Handle(ForwardPath, r.ForwardHandler())
+	mux.Handle(ReadIndexPath, r.ReadIndexHandler())
+	mux.Handle(MembershipPath, r.MembershipHandler())
+	mux.Handle(PeerPath, r.PeerHandler())
+	return r.mw.Wrap(mux)
+}
+
+// applyCMD applies the command to the raft log. Followers forward the command to the leader & wait until they have
+// applied it themselves so the result can be read back locally. Commands are retried while there is no leader.
+func (r *Resolver) applyCMD(ctx context.Context, cmd *fsm.CMD) (interface{}, error) {
+	bits, err := encode.Marshal(cmd)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * applyRetryInterval)
+		}
+		if r.raft.IsLeader() {
+			val, _, err := r.raft.Apply(bits)
+			if err == nil {
+				return val, nil
+			}
+			if !leadershipChanged(err) || attempt == applyRetries {
+				return nil, stacktrace.Propagate(err, "")
+			}
+			continue
+		}
+		leader := r.raft.LeaderAddr()
+		if leader == "" {
+			if attempt == applyRetries {
+				return nil, stacktrace.Propagate(constants.ErrUnavailable, "no raft leader after %v attempts", attempt+1)
+			}
+			continue
+		}
+		result, err := r.callMember(ctx, leader, ForwardPath, bits)
+		if err != nil {
+			if stacktrace.GetCode(err) != http.StatusServiceUnavailable || attempt == applyRetries {
+				return nil, stacktrace.Propagate(err, "")
+			}
+			logger.L.Warn("failed to forward command to leader - retrying", map[string]interface{}{
+				"leader":  leader,
+				"attempt": attempt,
+				"error":   err.Error(),
+			})
+			continue
+		}
+		return r.resultValue(result)
+	}
+}
+
+// callMember posts the body to an internal endpoint of a cluster member on behalf of the context user. Errors that may be
+// resolved by retrying, such as the member being unreachable or having lost leadership, have the code 503.
+func (r *Resolver) callMember(ctx context.Context, leader, path string, body []byte) (*fsm.Result, error) {
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s%s", leader, path), bytes.NewReader(body))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if token, ok := middleware.GetTokenCtx(ctx); ok {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := r.internal.Do(req)
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, http.StatusServiceUnavailable, "failed to reach leader %s", leader)
+	}
+	defer resp.Body.Close()
+	bits, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, http.StatusServiceUnavailable, "failed to read response from leader %s", leader)
+	}
+	result := &fsm.Result{}
+	if err := encode.Unmarshal(bits, result); err != nil {
+		return nil, stacktrace.PropagateWithCode(err, http.StatusServiceUnavailable, "bad response from leader %s: %s", leader, resp.Status)
+	}
+	if result.Error != "" {
+		return nil, stacktrace.NewErrorWithCode(stacktrace.ErrorCode(result.Code), result.Error)
+	}
+	return result, nil
+}
+
+// leadershipChanged returns true if the error is because leadership has moved, or is moving, to another node. Requests
+// that fail because of it may succeed once they're retried against the new leader.
+func leadershipChanged(err error) bool {
+	switch stacktrace.RootCause(err) {
+	case raft.ErrNotLeader, raft.ErrLeadershipLost, raft.ErrLeadershipTransferInProgress:
+		return true
+	}
+	return false
+}
+
+// resultValue waits for the forwarded command to be applied locally & returns the value the FSM returned on the leader
+func (r *Resolver) resultValue(result *fsm.Result) (interface{}, error) {
+	if err := r.raft.WaitForApplied(result.Index); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return r.loadResult(result)
+}
+
+// loadResult returns the node, relation or transaction results that the result refers to
+func (r *Resolver) loadResult(result *fsm.Result) (interface{}, error) {
+	switch {
+	case result.Node != nil:
+		n, err := r.graph.GetNode(result.Node.Type, result.Node.ID)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		return n, nil
+	case result.Relation != nil:
+		rel, err := r.graph.GetRelation(result.Relation.Type, result.Relation.ID)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		return rel, nil
+	case result.Results != nil:
+		entities := make([]api.Entity, len(result.Results))
+		for i, res := range result.Results {
+			val, err := r.loadResult(res)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "")
+			}
+			if ent, ok := val.(api.Entity); ok {
+				entities[i] = ent
+			}
+		}
+		return entities, nil
+	}
+	return result.Ok, nil
+}
+
+// toResult refers to the value returned by the FSM by the keys of the nodes & relations in it
+func toResult(val interface{}) *fsm.Result {
+	result := &fsm.Result{}
+	switch val := val.(type) {
+	case api.Node:
+		result.Node = &model.Key{Type: val.Type(), ID: val.ID()}
+	case api.Relation:
+		result.Relation = &model.Key{Type: val.Type(), ID: val.ID()}
+	case []api.Entity:
+		result.Results = make([]*fsm.Result, len(val))
+		for i, ent := range val {
+			result.Results[i] = toResult(ent)
+		}
+	case bool:
+		result.Ok = val
+	}
+	return result
+}
+
+// forwardRole returns the role that the user a command was forwarded for must have
+func forwardRole(method fsm.Method) config.Role {
+	switch method {
+	case fsm.MethodDefineNode, fsm.MethodDefineRelation, fsm.MethodDropNodeDefinition, fsm.MethodDropRelationDefinition:
+		return config.ADMIN
+	}
+	return config.WRITER
+}
+
+// ForwardHandler applies commands that were forwarded by followers once it has checked that the user they were
+// forwarded for may apply them. It responds with 503 if the node is not the leader so that followers retry against the
+// new leader.
+func (r *Resolver) ForwardHandler() http.Handler {
+	return r.leaderHandler(func(w http.ResponseWriter, req *http.Request) {
+		bits, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			writeResult(w, &fsm.Result{Code: http.StatusBadRequest, Error: err.Error()})
+			return
+		}
+		cmd := &fsm.CMD{}
+		if err := encode.Unmarshal(bits, cmd); err != nil {
+			writeResult(w, &fsm.Result{Code: http.StatusBadRequest, Error: err.Error()})
+			return
+		}
+		if _, err := r.mw.RequireRole(req.Context(), forwardRole(cmd.Method)); err != nil {
+			writeError(w, err)
+			return
+		}
+		val, index, err := r.raft.Apply(bits)
+		if err != nil {
+			code := int(stacktrace.GetCode(err))
+			switch {
+			case leadershipChanged(err):
+				code = http.StatusServiceUnavailable
+			case code == int(stacktrace.NoCode):
+				code = http.StatusInternalServerError
+			}
+			writeResult(w, &fsm.Result{Index: index, Code: code, Error: stacktrace.RootCause(err).Error()})
+			return
+		}
+		result := toResult(val)
+		result.Index, result.Code = index, http.StatusOK
+		writeResult(w, result)
+	})
+}
+
+// leaderHandler rejects requests from other members of the cluster with 503 if the node is not the leader
+func (r *Resolver) leaderHandler(handler http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !r.raft.IsLeader() {
+			writeResult(w, &fsm.Result{Code: http.StatusServiceUnavailable, Error: raft.ErrNotLeader.Error()})
+			return
+		}
+		handler(w, req)
+	})
+}
+
+// writeError writes the error with its code, or 500 if it doesn't have one
+func writeError(w http.ResponseWriter, err error) {
+	code := int(stacktrace.GetCode(err))
+	if code == int(stacktrace.NoCode) {
+		code = http.StatusInternalServerError
+	}
+	writeResult(w, &fsm.Result{Code: code, Error: stacktrace.RootCause(err).Error()})
+}
+
+func writeResult(w http.ResponseWriter, result *fsm.Result) {
+	bits, err := encode.Marshal(result)
+	if err != nil {
+		logger.L.Error("failed to encode forwarded command result", err, map[string]interface{}{})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(result.Code)
+	w.Write(bits)
+}
@@ -0,0 +1,221 @@
+package graph
+
+import (
+	"context"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/config"
+	"github.com/autom8ter/morpheus/pkg/constants"
+	"github.com/autom8ter/morpheus/pkg/graph/fsm"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/autom8ter/morpheus/pkg/middleware"
+	"github.com/autom8ter/morpheus/pkg/persistence"
+	"github.com/autom8ter/morpheus/pkg/raft"
+	"github.com/palantir/stacktrace"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testConfig = &config.Config{
+	Auth: &config.Auth{
+		SigningSecret: "test",
+		TokenTTL:      time.Hour,
+		Users: []config.User{
+			{Username: "admin", Password: "admin", Roles: []config.Role{config.ADMIN}},
+			{Username: "writer", Password: "writer", Roles: []config.Role{config.WRITER}},
+			{Username: "reader", Password: "reader", Roles: []config.Role{config.READER}},
+		},
+	},
+}
+
+type testNode struct {
+	id       string
+	addr     string
+	graph    api.Graph
+	raft     *raft.Raft
+	resolver *Resolver
+}
+
+// newTestNode starts a cluster member that listens on a random local port & serves its internal endpoints. If leader
+// is true, it bootstraps a cluster of its own.
+func newTestNode(t *testing.T, id string, leader bool) *testNode {
+	dir := t.TempDir()
+	g, err := persistence.New(dir + "/storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rft, err := raft.NewRaft(g.FSM(), lis,
+		raft.WithRaftDir(dir+"/raft"),
+		raft.WithPeerID(id),
+		raft.WithIsLeader(leader),
+		raft.WithClusterSecret("test-secret"),
+		raft.WithHeartbeatTimeout(200*time.Millisecond),
+		raft.WithElectionTimeout(200*time.Millisecond),
+		raft.WithLeaderLeaseTimeout(100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := NewResolver(g, rft, middleware.NewMiddleware(testConfig))
+	query := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(rft.PeerID()))
+	})
+	internal := &http.Server{Handler: resolver.InternalHandler(query)}
+	go internal.Serve(rft.RPCListener())
+	t.Cleanup(func() {
+		internal.Close()
+		rft.Close()
+		g.Close()
+	})
+	return &testNode{id: id, addr: rft.LocalAddr(), graph: g, raft: rft, resolver: resolver}
+}
+
+// newTestCluster starts a cluster with the given number of members. The first member is the leader.
+func newTestCluster(t *testing.T, size int) []*testNode {
+	nodes := []*testNode{newTestNode(t, "node-0", true)}
+	waitFor(t, "leader election", nodes[0].raft.IsLeader)
+	for i := 1; i < size; i++ {
+		node := newTestNode(t, "node-"+string(rune('0'+i)), false)
+		if err := nodes[0].raft.Join(node.id, node.addr); err != nil {
+			t.Fatal(err)
+		}
+		nodes = append(nodes, node)
+	}
+	for _, node := range nodes {
+		waitFor(t, "leader discovery", func() bool {
+			return node.raft.LeaderAddr() == nodes[0].addr
+		})
+	}
+	return nodes
+}
+
+func waitFor(t *testing.T, what string, fn func() bool) {
+	deadline := time.Now().Add(10 * time.Second)
+	for !fn() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// userCtx returns the context of a request made by the user
+func userCtx(t *testing.T, mw *middleware.Middleware, username string) context.Context {
+	token, err := mw.Login(username, username)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/query", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	var ctx context.Context
+	mw.Wrap(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx = req.Context()
+	})).ServeHTTP(httptest.NewRecorder(), req)
+	return ctx
+}
+
+func TestForward(t *testing.T) {
+	nodes := newTestCluster(t, 2)
+	follower := nodes[1]
+	writer := userCtx(t, follower.resolver.mw, "writer")
+	val, err := follower.resolver.applyCMD(writer, &fsm.CMD{
+		Method:    fsm.MethodSet,
+		Node:      model.Node{Type: "user", ID: "1", Properties: map[string]interface{}{"name": "coleman"}},
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the result is read back from the follower's store once it has applied the command
+	n, ok := val.(api.Node)
+	if !ok || n.Type() != "user" || n.ID() != "1" {
+		t.Fatalf("unexpected result: %v", val)
+	}
+	if _, err := follower.graph.GetNode("user", "1"); err != nil {
+		t.Fatal(err)
+	}
+	val, err = follower.resolver.applyCMD(writer, &fsm.CMD{
+		Method: fsm.MethodTransaction,
+		Ops: []*model.Op{
+			{SetNode: &model.SetNode{Type: "user", ID: "2"}},
+			{AddRelation: &model.AddRelation{Relation: "follows", Source: &model.Key{Type: "user", ID: "1"}, Target: &model.Key{Type: "user", ID: "2"}}},
+		},
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entities, ok := val.([]api.Entity)
+	if !ok || len(entities) != 2 || entities[0].ID() != "2" || entities[1].Type() != "follows" {
+		t.Fatalf("unexpected transaction result: %v", val)
+	}
+	// errors of the leader's FSM keep their code
+	_, err = follower.resolver.applyCMD(writer, &fsm.CMD{
+		Method:    fsm.MethodSet,
+		Node:      model.Node{Type: "user", ID: "1"},
+		Timestamp: time.Now(),
+		Metadata:  withIfVersion(map[string]string{}, func(v int) *int { return &v }(0)),
+	})
+	if stacktrace.GetCode(err) != stacktrace.GetCode(constants.ErrVersionConflict) {
+		t.Fatalf("expected a version conflict, got: %v", err)
+	}
+	// the leader checks the role of the user that the command was forwarded for
+	for _, test := range []struct {
+		ctx    context.Context
+		method fsm.Method
+		code   int
+	}{
+		{ctx: context.Background(), method: fsm.MethodSet, code: http.StatusUnauthorized},
+		{ctx: userCtx(t, follower.resolver.mw, "reader"), method: fsm.MethodSet, code: http.StatusForbidden},
+		{ctx: writer, method: fsm.MethodDefineNode, code: http.StatusForbidden},
+	} {
+		_, err := follower.resolver.applyCMD(test.ctx, &fsm.CMD{
+			Method:     test.method,
+			Node:       model.Node{Type: "user", ID: "3"},
+			Definition: &model.TypeDefinition{Type: "user", Mode: model.SchemaModeStrict},
+			Timestamp:  time.Now(),
+		})
+		if int(stacktrace.GetCode(err)) != test.code {
+			t.Fatalf("%s: expected code %v, got: %v", test.method, test.code, err)
+		}
+	}
+	if _, err := follower.resolver.applyCMD(userCtx(t, follower.resolver.mw, "admin"), &fsm.CMD{
+		Method:     fsm.MethodDefineNode,
+		Definition: &model.TypeDefinition{Type: "user", Mode: model.SchemaModeWarn},
+		Timestamp:  time.Now(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestForwardRetries(t *testing.T) {
+	node := newTestNode(t, "node-0", false)
+	cmd := &fsm.CMD{
+		Method:    fsm.MethodSet,
+		Node:      model.Node{Type: "user", ID: "1"},
+		Timestamp: time.Now(),
+	}
+	// commands fail once the retries are exhausted while there is no leader
+	start := time.Now()
+	_, err := node.resolver.applyCMD(context.Background(), cmd)
+	if stacktrace.GetCode(err) != http.StatusServiceUnavailable {
+		t.Fatalf("expected the command to be unavailable, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 15*applyRetryInterval {
+		t.Fatalf("expected %v retries, gave up after %v", applyRetries, elapsed)
+	}
+	// commands succeed once a leader is elected while they're being retried
+	go func() {
+		time.Sleep(applyRetryInterval)
+		node.raft.Bootstrap(map[string]string{node.id: node.addr})
+	}()
+	if _, err := node.resolver.applyCMD(context.Background(), cmd); err != nil {
+		t.Fatal(err)
+	}
+}
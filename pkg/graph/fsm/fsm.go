@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/raft"
 	"github.com/palantir/stacktrace"
 	"io"
+	"strconv"
 	"time"
 )
 
@@ -54,6 +55,22 @@ const (
 	MethodBulkAdd           Method = "bulk_add"
 	MethodBulkSet           Method = "bulk_set"
 	MethodBulkDel           Method = "bulk_del"
+	// MethodBulkMerge merges the properties of each node into its existing properties
+	MethodBulkMerge Method = "bulk_merge"
+	// MethodTransaction applies every operation in a single transaction
+	MethodTransaction Method = "transaction"
+	// MethodNodePatchProperties merges the patch into the properties of the node
+	MethodNodePatchProperties Method = "node.patch_properties"
+	// MethodRelationPatchProperties merges the patch into the properties of the relation
+	MethodRelationPatchProperties Method = "relation.patch_properties"
+	// MethodDefineNode & MethodDefineRelation register the definition of a node type or relation
+	MethodDefineNode     Method = "define_node"
+	MethodDefineRelation Method = "define_relation"
+	// MethodDropNodeDefinition & MethodDropRelationDefinition remove the definition of a node type or relation
+	MethodDropNodeDefinition     Method = "drop_node_definition"
+	MethodDropRelationDefinition Method = "drop_relation_definition"
+	// MethodUpsert finds or creates a node by the values of a unique constraint
+	MethodUpsert Method = "upsert"
 )
 
 type CMD struct {
@@ -65,10 +82,30 @@ type CMD struct {
 	Key        model.Key
 	Keys       []*model.Key
 	Properties map[string]interface{}
+	Patch      *model.Patch
+	Definition *model.TypeDefinition
+	Upsert     *model.Upsert
+	Ops        []*model.Op
 	Timestamp  time.Time         `json:"timestamp"`
 	Metadata   map[string]string `json:"metadata"`
 }
 
+// Detach returns whether a delete command should also delete the relations of the node(s) being deleted.
+// It defaults to true so commands without the detach metadata cascade.
+func (c CMD) Detach() bool {
+	return c.Metadata["detach"] != "false"
+}
+
+// IfVersion returns the version the entity must have for a write command to be applied, or nil if the command doesn't
+// have a version precondition
+func (c CMD) IfVersion() *int {
+	version, err := strconv.Atoi(c.Metadata["if_version"])
+	if err != nil {
+		return nil
+	}
+	return &version
+}
+
 type CMDHandlerFunc func(c CMD) ([]interface{}, error)
 
 func NewFSM(handlers ...CMDHandlerFunc) raft.FSM {
@@ -97,3 +134,18 @@ func NewFSM(handlers ...CMDHandlerFunc) raft.FSM {
 		},
 	}
 }
+
+// Result is the outcome of a command that was forwarded to & applied by the raft leader
+type Result struct {
+	// Index is the index of the command in the raft log
+	Index    uint64     `json:"index"`
+	Node     *model.Key `json:"node"`
+	Relation *model.Key `json:"relation"`
+	Ok       bool       `json:"ok"`
+	Code     int        `json:"code"`
+	Error    string     `json:"error"`
+	// Data is the encoded response of requests that aren't commands
+	Data []byte `json:"data"`
+	// Results are the results of each operation of a transaction
+	Results []*Result `json:"results"`
+}
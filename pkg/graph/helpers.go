@@ -1,11 +1,16 @@
 package graph
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/config"
+	"github.com/autom8ter/morpheus/pkg/constants"
 	"github.com/autom8ter/morpheus/pkg/graph/model"
 	"github.com/palantir/stacktrace"
+	"github.com/vektah/gqlparser/v2/ast"
 	"strconv"
 	"strings"
 )
@@ -46,6 +51,29 @@ func toRelation(rel api.Relation) (*model.Relation, error) {
 		Target: targetNode,
 	}, nil
 }
+func toPath(path *api.Path) (*model.Path, error) {
+	resp := &model.Path{
+		Nodes:     []*model.Node{},
+		Relations: []*model.Relation{},
+		Weight:    path.Weight,
+	}
+	for _, n := range path.Nodes {
+		node, err := toNode(n)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		resp.Nodes = append(resp.Nodes, node)
+	}
+	for _, rel := range path.Relations {
+		relation, err := toRelation(rel)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		resp.Relations = append(resp.Relations, relation)
+	}
+	return resp, nil
+}
+
 func (r *Resolver) parseCursor(cursor string) (int, error) {
 	bits, err := base64.StdEncoding.DecodeString(cursor)
 	if err != nil {
@@ -57,3 +85,60 @@ func (r *Resolver) parseCursor(cursor string) (int, error) {
 	}
 	return strconv.Atoi(split[1])
 }
+
+// requireWriter checks that the context user has the WRITER role and that the operation is a mutation so that nested
+// fields that modify the graph cannot be executed by a query
+func (r *Resolver) requireWriter(ctx context.Context) (config.User, error) {
+	if op := graphql.GetOperationContext(ctx); op.Operation == nil || op.Operation.Operation != ast.Mutation {
+		return config.User{}, stacktrace.Propagate(constants.ErrForbidden, "writes are only permitted within a mutation")
+	}
+	return r.mw.RequireRole(ctx, config.WRITER)
+}
+
+// requested returns whether the field is selected on the result of the field being resolved, so that fields that are
+// expensive to compute are only computed when they're asked for
+func requested(ctx context.Context, field string) bool {
+	for _, f := range graphql.CollectAllFields(ctx) {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// withIfVersion adds the version precondition of a write to the command metadata
+func withIfVersion(metadata map[string]string, ifVersion *int) map[string]string {
+	if ifVersion != nil {
+		metadata["if_version"] = strconv.Itoa(*ifVersion)
+	}
+	return metadata
+}
+
+// toTypeDefinition converts the input to a definition, filling in the defaults of unset fields
+func toTypeDefinition(input model.TypeDefinitionInput) *model.TypeDefinition {
+	def := &model.TypeDefinition{
+		Type:   input.Type,
+		Unique: input.Unique,
+		Mode:   model.SchemaModeStrict,
+	}
+	if input.Mode != nil {
+		def.Mode = *input.Mode
+	}
+	if input.AdditionalProperties != nil {
+		def.AdditionalProperties = *input.AdditionalProperties
+	}
+	for _, prop := range input.Properties {
+		def.Properties = append(def.Properties, &model.PropertyDefinition{
+			Name:     prop.Name,
+			Kind:     prop.Kind,
+			Required: prop.Required != nil && *prop.Required,
+			Default:  prop.Default,
+			Enum:     prop.Enum,
+			Regex:    prop.Regex,
+		})
+	}
+	for _, endpoint := range input.Endpoints {
+		def.Endpoints = append(def.Endpoints, &model.Endpoint{Source: endpoint.Source, Target: endpoint.Target})
+	}
+	return def
+}
@@ -18,46 +18,165 @@ type AddNode struct {
 	Properties map[string]interface{} `json:"properties"`
 }
 
+type AddRelation struct {
+	Source     *Key                   `json:"source"`
+	Direction  *Direction             `json:"direction"`
+	Relation   string                 `json:"relation"`
+	Properties map[string]interface{} `json:"properties"`
+	Target     *Key                   `json:"target"`
+}
+
+type AggregateRow struct {
+	Group   map[string]interface{} `json:"group"`
+	Metrics []*MetricValue         `json:"metrics"`
+}
+
+type Bucket struct {
+	Start float64 `json:"start"`
+	Count int     `json:"count"`
+}
+
+type ClusterServer struct {
+	ID       string `json:"id"`
+	Address  string `json:"address"`
+	Suffrage string `json:"suffrage"`
+	Leader   bool   `json:"leader"`
+}
+
+type ClusterStatus struct {
+	PeerID  string                 `json:"peer_id"`
+	State   string                 `json:"state"`
+	Leader  string                 `json:"leader"`
+	Servers []*ClusterServer       `json:"servers"`
+	Stats   map[string]interface{} `json:"stats"`
+}
+
+type CypherResult struct {
+	Columns []string        `json:"columns"`
+	Rows    [][]interface{} `json:"rows"`
+}
+
+type DelNode struct {
+	Key       *Key  `json:"key"`
+	Detach    *bool `json:"detach"`
+	IfVersion *int  `json:"ifVersion"`
+}
+
+type Endpoint struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+type EndpointInput struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
 type Expression struct {
 	Key      string      `json:"key"`
 	Operator Operator    `json:"operator"`
 	Value    interface{} `json:"value"`
 }
 
+type Filter struct {
+	Expressions []*Expression `json:"expressions"`
+	And         []*Filter     `json:"and"`
+	Or          []*Filter     `json:"or"`
+	Not         *Filter       `json:"not"`
+}
+
 type Key struct {
 	Type string `json:"type"`
 	ID   string `json:"id"`
 }
 
+type Metric struct {
+	Fn         AggregateFunction `json:"fn"`
+	Field      *string           `json:"field"`
+	Percentile *float64          `json:"percentile"`
+	BucketSize *float64          `json:"bucketSize"`
+}
+
+type MetricValue struct {
+	Fn      AggregateFunction `json:"fn"`
+	Field   *string           `json:"field"`
+	Value   *float64          `json:"value"`
+	Buckets []*Bucket         `json:"buckets"`
+}
+
 type Node struct {
-	ID              string                 `json:"id"`
-	Type            string                 `json:"type"`
-	Properties      map[string]interface{} `json:"properties"`
-	GetProperty     interface{}            `json:"getProperty"`
-	SetProperties   bool                   `json:"setProperties"`
-	DelProperty     bool                   `json:"delProperty"`
-	GetRelation     *Relation              `json:"getRelation"`
-	AddRelation     *Relation              `json:"addRelation"`
-	DelRelation     bool                   `json:"delRelation"`
-	Relations       *Relations             `json:"relations"`
-	AddIncomingNode *Node                  `json:"addIncomingNode"`
-	AddOutboundNode *Node                  `json:"addOutboundNode"`
+	ID                string                 `json:"id"`
+	Type              string                 `json:"type"`
+	Properties        map[string]interface{} `json:"properties"`
+	GetProperty       interface{}            `json:"getProperty"`
+	SetProperties     bool                   `json:"setProperties"`
+	ReplaceProperties bool                   `json:"replaceProperties"`
+	PatchProperties   bool                   `json:"patchProperties"`
+	DelProperty       bool                   `json:"delProperty"`
+	GetRelation       *Relation              `json:"getRelation"`
+	AddRelation       *Relation              `json:"addRelation"`
+	DelRelation       bool                   `json:"delRelation"`
+	Relations         *Relations             `json:"relations"`
+	AddIncomingNode   *Node                  `json:"addIncomingNode"`
+	AddOutboundNode   *Node                  `json:"addOutboundNode"`
 }
 
 func (Node) IsEntity() {}
 
+type NodeChange struct {
+	Change     ChangeType             `json:"change"`
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type NodeGroup struct {
+	Node  *Node `json:"node"`
+	Group int   `json:"group"`
+}
+
+type NodeSchema struct {
+	Type       string            `json:"type"`
+	Properties []*PropertySchema `json:"properties"`
+	Relations  []*RelationSchema `json:"relations"`
+}
+
+type NodeScore struct {
+	Node  *Node   `json:"node"`
+	Score float64 `json:"score"`
+}
+
 type NodeWhere struct {
 	Cursor      *string       `json:"cursor"`
 	Type        string        `json:"type"`
 	Expressions []*Expression `json:"expressions"`
+	Filter      *Filter       `json:"filter"`
 	PageSize    *int          `json:"page_size"`
 	OrderBy     *OrderBy      `json:"order_by"`
 }
 
 type Nodes struct {
-	Cursor string  `json:"cursor"`
-	Values []*Node `json:"values"`
-	Agg    float64 `json:"agg"`
+	Cursor     string  `json:"cursor"`
+	Values     []*Node `json:"values"`
+	TotalCount int     `json:"totalCount"`
+	Agg        float64 `json:"agg"`
+}
+
+type Op struct {
+	AddNode           *AddNode         `json:"addNode"`
+	SetNode           *SetNode         `json:"setNode"`
+	DelNode           *DelNode         `json:"delNode"`
+	AddRelation       *AddRelation     `json:"addRelation"`
+	DelRelation       *Key             `json:"delRelation"`
+	SetProperties     *SetProperties   `json:"setProperties"`
+	ReplaceProperties *SetProperties   `json:"replaceProperties"`
+	PatchProperties   *PatchProperties `json:"patchProperties"`
+	Upsert            *Upsert          `json:"upsert"`
+}
+
+type OpResult struct {
+	Node     *Node     `json:"node"`
+	Relation *Relation `json:"relation"`
 }
 
 type OrderBy struct {
@@ -65,49 +184,152 @@ type OrderBy struct {
 	Reverse *bool  `json:"reverse"`
 }
 
+type Patch struct {
+	Set       map[string]interface{} `json:"set"`
+	Increment map[string]interface{} `json:"increment"`
+	Append    map[string]interface{} `json:"append"`
+	Remove    map[string]interface{} `json:"remove"`
+}
+
+type PatchProperties struct {
+	Node      *Key   `json:"node"`
+	Relation  *Key   `json:"relation"`
+	Patch     *Patch `json:"patch"`
+	IfVersion *int   `json:"ifVersion"`
+}
+
+type Path struct {
+	Nodes     []*Node     `json:"nodes"`
+	Relations []*Relation `json:"relations"`
+	Weight    float64     `json:"weight"`
+}
+
+type PropertyDefinition struct {
+	Name     string        `json:"name"`
+	Kind     *ValueKind    `json:"kind"`
+	Required bool          `json:"required"`
+	Default  interface{}   `json:"default"`
+	Enum     []interface{} `json:"enum"`
+	Regex    *string       `json:"regex"`
+}
+
+type PropertyDefinitionInput struct {
+	Name     string        `json:"name"`
+	Kind     *ValueKind    `json:"kind"`
+	Required *bool         `json:"required"`
+	Default  interface{}   `json:"default"`
+	Enum     []interface{} `json:"enum"`
+	Regex    *string       `json:"regex"`
+}
+
+type PropertySchema struct {
+	Name  string      `json:"name"`
+	Kinds []ValueKind `json:"kinds"`
+}
+
 type Relation struct {
-	ID            string                 `json:"id"`
-	Type          string                 `json:"type"`
-	Properties    map[string]interface{} `json:"properties"`
-	GetProperty   interface{}            `json:"getProperty"`
-	SetProperties bool                   `json:"setProperties"`
-	DelProperty   bool                   `json:"delProperty"`
-	Source        *Node                  `json:"source"`
-	Target        *Node                  `json:"target"`
+	ID                string                 `json:"id"`
+	Type              string                 `json:"type"`
+	Properties        map[string]interface{} `json:"properties"`
+	GetProperty       interface{}            `json:"getProperty"`
+	SetProperties     bool                   `json:"setProperties"`
+	ReplaceProperties bool                   `json:"replaceProperties"`
+	PatchProperties   bool                   `json:"patchProperties"`
+	DelProperty       bool                   `json:"delProperty"`
+	Source            *Node                  `json:"source"`
+	Target            *Node                  `json:"target"`
 }
 
 func (Relation) IsEntity() {}
 
+type RelationChange struct {
+	Change     ChangeType             `json:"change"`
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type RelationSchema struct {
+	Relation   string            `json:"relation"`
+	Direction  Direction         `json:"direction"`
+	TargetType string            `json:"target_type"`
+	Properties []*PropertySchema `json:"properties"`
+}
+
 type RelationWhere struct {
 	Cursor      *string       `json:"cursor"`
 	Direction   Direction     `json:"direction"`
 	Relation    string        `json:"relation"`
 	TargetType  string        `json:"target_type"`
 	Expressions []*Expression `json:"expressions"`
+	Filter      *Filter       `json:"filter"`
 	PageSize    *int          `json:"page_size"`
 	OrderBy     *OrderBy      `json:"order_by"`
 }
 
 type Relations struct {
-	Cursor string      `json:"cursor"`
-	Values []*Relation `json:"values"`
-	Agg    float64     `json:"agg"`
+	Cursor     string      `json:"cursor"`
+	Values     []*Relation `json:"values"`
+	TotalCount int         `json:"totalCount"`
+	Agg        float64     `json:"agg"`
 }
 
 type SetNode struct {
 	Type       string                 `json:"type"`
 	ID         string                 `json:"id"`
 	Properties map[string]interface{} `json:"properties"`
+	IfVersion  *int                   `json:"ifVersion"`
+}
+
+type SetProperties struct {
+	Node       *Key                   `json:"node"`
+	Relation   *Key                   `json:"relation"`
+	Properties map[string]interface{} `json:"properties"`
+	IfVersion  *int                   `json:"ifVersion"`
+}
+
+type Traversal struct {
+	Node  *Node       `json:"node"`
+	Depth int         `json:"depth"`
+	Path  []*Relation `json:"path"`
+}
+
+type TypeDefinition struct {
+	Type                 string                `json:"type"`
+	Properties           []*PropertyDefinition `json:"properties"`
+	Endpoints            []*Endpoint           `json:"endpoints"`
+	Unique               [][]string            `json:"unique"`
+	AdditionalProperties bool                  `json:"additionalProperties"`
+	Mode                 SchemaMode            `json:"mode"`
+}
+
+type TypeDefinitionInput struct {
+	Type                 string                     `json:"type"`
+	Properties           []*PropertyDefinitionInput `json:"properties"`
+	Endpoints            []*EndpointInput           `json:"endpoints"`
+	Unique               [][]string                 `json:"unique"`
+	AdditionalProperties *bool                      `json:"additionalProperties"`
+	Mode                 *SchemaMode                `json:"mode"`
+}
+
+type Upsert struct {
+	Type       string                 `json:"type"`
+	ID         *string                `json:"id"`
+	Match      map[string]interface{} `json:"match"`
+	Properties map[string]interface{} `json:"properties"`
 }
 
 type AggregateFunction string
 
 const (
-	AggregateFunctionSum   AggregateFunction = "SUM"
-	AggregateFunctionCount AggregateFunction = "COUNT"
-	AggregateFunctionAvg   AggregateFunction = "AVG"
-	AggregateFunctionMax   AggregateFunction = "MAX"
-	AggregateFunctionMin   AggregateFunction = "MIN"
+	AggregateFunctionSum           AggregateFunction = "SUM"
+	AggregateFunctionCount         AggregateFunction = "COUNT"
+	AggregateFunctionAvg           AggregateFunction = "AVG"
+	AggregateFunctionMax           AggregateFunction = "MAX"
+	AggregateFunctionMin           AggregateFunction = "MIN"
+	AggregateFunctionCountDistinct AggregateFunction = "COUNT_DISTINCT"
+	AggregateFunctionPercentile    AggregateFunction = "PERCENTILE"
+	AggregateFunctionHistogram     AggregateFunction = "HISTOGRAM"
 )
 
 var AllAggregateFunction = []AggregateFunction{
@@ -116,11 +338,14 @@ var AllAggregateFunction = []AggregateFunction{
 	AggregateFunctionAvg,
 	AggregateFunctionMax,
 	AggregateFunctionMin,
+	AggregateFunctionCountDistinct,
+	AggregateFunctionPercentile,
+	AggregateFunctionHistogram,
 }
 
 func (e AggregateFunction) IsValid() bool {
 	switch e {
-	case AggregateFunctionSum, AggregateFunctionCount, AggregateFunctionAvg, AggregateFunctionMax, AggregateFunctionMin:
+	case AggregateFunctionSum, AggregateFunctionCount, AggregateFunctionAvg, AggregateFunctionMax, AggregateFunctionMin, AggregateFunctionCountDistinct, AggregateFunctionPercentile, AggregateFunctionHistogram:
 		return true
 	}
 	return false
@@ -147,6 +372,47 @@ func (e AggregateFunction) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ChangeType string
+
+const (
+	ChangeTypeSet    ChangeType = "SET"
+	ChangeTypeDelete ChangeType = "DELETE"
+)
+
+var AllChangeType = []ChangeType{
+	ChangeTypeSet,
+	ChangeTypeDelete,
+}
+
+func (e ChangeType) IsValid() bool {
+	switch e {
+	case ChangeTypeSet, ChangeTypeDelete:
+		return true
+	}
+	return false
+}
+
+func (e ChangeType) String() string {
+	return string(e)
+}
+
+func (e *ChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeType", str)
+	}
+	return nil
+}
+
+func (e ChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Direction string
 
 const (
@@ -191,15 +457,23 @@ func (e Direction) MarshalGQL(w io.Writer) {
 type Operator string
 
 const (
-	OperatorEq        Operator = "EQ"
-	OperatorNeq       Operator = "NEQ"
-	OperatorGt        Operator = "GT"
-	OperatorLt        Operator = "LT"
-	OperatorGte       Operator = "GTE"
-	OperatorLte       Operator = "LTE"
-	OperatorContains  Operator = "CONTAINS"
-	OperatorHasPrefix Operator = "HAS_PREFIX"
-	OperatorHasSuffix Operator = "HAS_SUFFIX"
+	OperatorEq          Operator = "EQ"
+	OperatorNeq         Operator = "NEQ"
+	OperatorGt          Operator = "GT"
+	OperatorLt          Operator = "LT"
+	OperatorGte         Operator = "GTE"
+	OperatorLte         Operator = "LTE"
+	OperatorContains    Operator = "CONTAINS"
+	OperatorHasPrefix   Operator = "HAS_PREFIX"
+	OperatorHasSuffix   Operator = "HAS_SUFFIX"
+	OperatorIn          Operator = "IN"
+	OperatorNotIn       Operator = "NOT_IN"
+	OperatorExists      Operator = "EXISTS"
+	OperatorNotExists   Operator = "NOT_EXISTS"
+	OperatorRegex       Operator = "REGEX"
+	OperatorBetween     Operator = "BETWEEN"
+	OperatorContainsAny Operator = "CONTAINS_ANY"
+	OperatorContainsAll Operator = "CONTAINS_ALL"
 )
 
 var AllOperator = []Operator{
@@ -212,11 +486,19 @@ var AllOperator = []Operator{
 	OperatorContains,
 	OperatorHasPrefix,
 	OperatorHasSuffix,
+	OperatorIn,
+	OperatorNotIn,
+	OperatorExists,
+	OperatorNotExists,
+	OperatorRegex,
+	OperatorBetween,
+	OperatorContainsAny,
+	OperatorContainsAll,
 }
 
 func (e Operator) IsValid() bool {
 	switch e {
-	case OperatorEq, OperatorNeq, OperatorGt, OperatorLt, OperatorGte, OperatorLte, OperatorContains, OperatorHasPrefix, OperatorHasSuffix:
+	case OperatorEq, OperatorNeq, OperatorGt, OperatorLt, OperatorGte, OperatorLte, OperatorContains, OperatorHasPrefix, OperatorHasSuffix, OperatorIn, OperatorNotIn, OperatorExists, OperatorNotExists, OperatorRegex, OperatorBetween, OperatorContainsAny, OperatorContainsAll:
 		return true
 	}
 	return false
@@ -242,3 +524,138 @@ func (e *Operator) UnmarshalGQL(v interface{}) error {
 func (e Operator) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type SchemaMode string
+
+const (
+	SchemaModeStrict SchemaMode = "STRICT"
+	SchemaModeWarn   SchemaMode = "WARN"
+)
+
+var AllSchemaMode = []SchemaMode{
+	SchemaModeStrict,
+	SchemaModeWarn,
+}
+
+func (e SchemaMode) IsValid() bool {
+	switch e {
+	case SchemaModeStrict, SchemaModeWarn:
+		return true
+	}
+	return false
+}
+
+func (e SchemaMode) String() string {
+	return string(e)
+}
+
+func (e *SchemaMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SchemaMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SchemaMode", str)
+	}
+	return nil
+}
+
+func (e SchemaMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TraverseAlgorithm string
+
+const (
+	TraverseAlgorithmBfs TraverseAlgorithm = "BFS"
+	TraverseAlgorithmDfs TraverseAlgorithm = "DFS"
+)
+
+var AllTraverseAlgorithm = []TraverseAlgorithm{
+	TraverseAlgorithmBfs,
+	TraverseAlgorithmDfs,
+}
+
+func (e TraverseAlgorithm) IsValid() bool {
+	switch e {
+	case TraverseAlgorithmBfs, TraverseAlgorithmDfs:
+		return true
+	}
+	return false
+}
+
+func (e TraverseAlgorithm) String() string {
+	return string(e)
+}
+
+func (e *TraverseAlgorithm) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TraverseAlgorithm(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TraverseAlgorithm", str)
+	}
+	return nil
+}
+
+func (e TraverseAlgorithm) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ValueKind string
+
+const (
+	ValueKindNull   ValueKind = "NULL"
+	ValueKindString ValueKind = "STRING"
+	ValueKindInt    ValueKind = "INT"
+	ValueKindFloat  ValueKind = "FLOAT"
+	ValueKindBool   ValueKind = "BOOL"
+	ValueKindTime   ValueKind = "TIME"
+	ValueKindMap    ValueKind = "MAP"
+	ValueKindArray  ValueKind = "ARRAY"
+)
+
+var AllValueKind = []ValueKind{
+	ValueKindNull,
+	ValueKindString,
+	ValueKindInt,
+	ValueKindFloat,
+	ValueKindBool,
+	ValueKindTime,
+	ValueKindMap,
+	ValueKindArray,
+}
+
+func (e ValueKind) IsValid() bool {
+	switch e {
+	case ValueKindNull, ValueKindString, ValueKindInt, ValueKindFloat, ValueKindBool, ValueKindTime, ValueKindMap, ValueKindArray:
+		return true
+	}
+	return false
+}
+
+func (e ValueKind) String() string {
+	return string(e)
+}
+
+func (e *ValueKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ValueKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ValueKind", str)
+	}
+	return nil
+}
+
+func (e ValueKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
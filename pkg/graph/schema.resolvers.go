@@ -6,13 +6,16 @@ package graph
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/autom8ter/morpheus/pkg/analytics"
 	"github.com/autom8ter/morpheus/pkg/api"
 	"github.com/autom8ter/morpheus/pkg/config"
 	"github.com/autom8ter/morpheus/pkg/constants"
+	"github.com/autom8ter/morpheus/pkg/cypher"
 	"github.com/autom8ter/morpheus/pkg/graph/fsm"
 	"github.com/autom8ter/morpheus/pkg/graph/generated"
 	"github.com/autom8ter/morpheus/pkg/graph/model"
@@ -23,6 +26,601 @@ import (
 	"github.com/spf13/cast"
 )
 
+func (r *mutationResolver) Get(ctx context.Context, key model.Key) (*model.Node, error) {
+	return r.Query().Get(ctx, key)
+}
+
+func (r *mutationResolver) Add(ctx context.Context, add model.AddNode) (*model.Node, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	a := &add
+	if a.ID == nil {
+		id := uuid.New().String()
+		a.ID = &id
+	}
+	cmd := &fsm.CMD{
+		Method: fsm.MethodAdd,
+		Node: model.Node{
+			ID:         *a.ID,
+			Type:       a.Type,
+			Properties: a.Properties,
+		},
+		Timestamp: time.Now(),
+		Metadata:  nil,
+	}
+	result, err := r.applyCMD(ctx, cmd)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.id":        *add.ID,
+			"node.type":      add.Type,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	node, err := toNode(result.(api.Node))
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.id":        *add.ID,
+			"node.type":      add.Type,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return node, nil
+}
+
+func (r *mutationResolver) Set(ctx context.Context, set model.SetNode) (*model.Node, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	cmd := &fsm.CMD{
+		Method: fsm.MethodSet,
+		Node: model.Node{
+			ID:         set.ID,
+			Type:       set.Type,
+			Properties: set.Properties,
+		},
+		Timestamp: time.Now(),
+		Metadata:  withIfVersion(map[string]string{}, set.IfVersion),
+	}
+	result, err := r.applyCMD(ctx, cmd)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.id":        set.ID,
+			"node.type":      set.Type,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	n, err := toNode(result.(api.Node))
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.id":        set.ID,
+			"node.type":      set.Type,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return n, nil
+}
+
+func (r *mutationResolver) Upsert(ctx context.Context, typeArg string, match map[string]interface{}, properties map[string]interface{}) (*model.Node, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	id := uuid.New().String()
+	cmd := &fsm.CMD{
+		Method: fsm.MethodUpsert,
+		Upsert: &model.Upsert{
+			Type:       typeArg,
+			ID:         &id,
+			Match:      match,
+			Properties: properties,
+		},
+		Timestamp: time.Now(),
+	}
+	result, err := r.applyCMD(ctx, cmd)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.type":      typeArg,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	n, err := toNode(result.(api.Node))
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.type":      typeArg,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return n, nil
+}
+
+func (r *mutationResolver) Del(ctx context.Context, del model.Key, detach *bool, ifVersion *int) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	cmd := &fsm.CMD{
+		Method:    fsm.MethodDel,
+		Key:       del,
+		Timestamp: time.Now(),
+		Metadata: withIfVersion(map[string]string{
+			"detach": strconv.FormatBool(detach == nil || *detach),
+		}, ifVersion),
+	}
+	_, err = r.applyCMD(ctx, cmd)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.id":        del.ID,
+			"node.type":      del.Type,
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) BulkAdd(ctx context.Context, add []*model.AddNode) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+
+	for _, a := range add {
+		if a.ID == nil {
+			id := uuid.New().String()
+			a.ID = &id
+		}
+	}
+	cmd := &fsm.CMD{
+		Method:    fsm.MethodBulkAdd,
+		AddNodes:  add,
+		Timestamp: time.Now(),
+		Metadata:  nil,
+	}
+	_, err = r.applyCMD(ctx, cmd)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) BulkSet(ctx context.Context, set []*model.SetNode) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	cmd := &fsm.CMD{
+		Method:    fsm.MethodBulkSet,
+		SetNodes:  set,
+		Timestamp: time.Now(),
+	}
+	_, err = r.applyCMD(ctx, cmd)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) BulkDel(ctx context.Context, del []*model.Key, detach *bool) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	cmd := &fsm.CMD{
+		Method:    fsm.MethodBulkDel,
+		Keys:      del,
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"detach": strconv.FormatBool(detach == nil || *detach),
+		},
+	}
+	_, err = r.applyCMD(ctx, cmd)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) Transaction(ctx context.Context, ops []*model.Op) ([]*model.OpResult, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	for _, o := range ops {
+		if o.AddNode != nil && o.AddNode.ID == nil {
+			id := uuid.New().String()
+			o.AddNode.ID = &id
+		}
+		if o.Upsert != nil && o.Upsert.ID == nil {
+			id := uuid.New().String()
+			o.Upsert.ID = &id
+		}
+	}
+	cmd := &fsm.CMD{
+		Method:    fsm.MethodTransaction,
+		Ops:       ops,
+		Timestamp: time.Now(),
+	}
+	val, err := r.applyCMD(ctx, cmd)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	entities, _ := val.([]api.Entity)
+	results := make([]*model.OpResult, len(ops))
+	for i := range ops {
+		results[i] = &model.OpResult{}
+		if i >= len(entities) {
+			continue
+		}
+		switch ent := entities[i].(type) {
+		case api.Node:
+			results[i].Node, err = toNode(ent)
+		case api.Relation:
+			results[i].Relation, err = toRelation(ent)
+		}
+		if err != nil {
+			logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+				"operation.name": op.OperationName,
+			})
+			return nil, stacktrace.RootCause(err)
+		}
+	}
+	return results, nil
+}
+
+func (r *mutationResolver) DefineNode(ctx context.Context, definition model.TypeDefinitionInput) (*model.TypeDefinition, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.ADMIN)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	def := toTypeDefinition(definition)
+	cmd := &fsm.CMD{
+		Method:     fsm.MethodDefineNode,
+		Definition: def,
+		Timestamp:  time.Now(),
+	}
+	if _, err := r.applyCMD(ctx, cmd); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"definition":     definition.Type,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return def, nil
+}
+
+func (r *mutationResolver) DefineRelation(ctx context.Context, definition model.TypeDefinitionInput) (*model.TypeDefinition, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.ADMIN)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	def := toTypeDefinition(definition)
+	cmd := &fsm.CMD{
+		Method:     fsm.MethodDefineRelation,
+		Definition: def,
+		Timestamp:  time.Now(),
+	}
+	if _, err := r.applyCMD(ctx, cmd); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"definition":     definition.Type,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return def, nil
+}
+
+func (r *mutationResolver) DropNodeDefinition(ctx context.Context, typeArg string) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.ADMIN)
+	if err != nil {
+		return false, stacktrace.RootCause(err)
+	}
+	cmd := &fsm.CMD{
+		Method:    fsm.MethodDropNodeDefinition,
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"type": typeArg,
+		},
+	}
+	if _, err := r.applyCMD(ctx, cmd); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"definition":     typeArg,
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) DropRelationDefinition(ctx context.Context, relation string) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.ADMIN)
+	if err != nil {
+		return false, stacktrace.RootCause(err)
+	}
+	cmd := &fsm.CMD{
+		Method:    fsm.MethodDropRelationDefinition,
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"type": relation,
+		},
+	}
+	if _, err := r.applyCMD(ctx, cmd); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"definition":     relation,
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) Login(ctx context.Context, username string, password string) (string, error) {
+	op := graphql.GetOperationContext(ctx)
+	token, err := r.mw.Login(username, password)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"username":       username,
+		})
+		return "", stacktrace.RootCause(err)
+	}
+	expired, _, err := helpers.JWTExpired(token)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"username":       username,
+		})
+		return "", stacktrace.RootCause(err)
+	}
+	if expired {
+		return "", fmt.Errorf("expired jwt (internal): %s", token)
+	}
+	return token, nil
+}
+
+func (r *mutationResolver) ClusterJoin(ctx context.Context, id string, address string) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.ADMIN)
+	if err != nil {
+		return false, stacktrace.RootCause(err)
+	}
+	if err := r.changeMembership(ctx, &membershipChange{
+		Op:      membershipJoin,
+		ID:      id,
+		Address: address,
+	}); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"peer.id":        id,
+			"peer.address":   address,
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) ClusterLeave(ctx context.Context) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.ADMIN)
+	if err != nil {
+		return false, stacktrace.RootCause(err)
+	}
+	if err := r.changeMembership(ctx, &membershipChange{
+		Op: membershipRemove,
+		ID: r.raft.PeerID(),
+	}); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"peer.id":        r.raft.PeerID(),
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) ClusterRemove(ctx context.Context, id string) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.ADMIN)
+	if err != nil {
+		return false, stacktrace.RootCause(err)
+	}
+	if err := r.changeMembership(ctx, &membershipChange{
+		Op: membershipRemove,
+		ID: id,
+	}); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"peer.id":        id,
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) ClusterTransferLeadership(ctx context.Context, id *string, address *string) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.ADMIN)
+	if err != nil {
+		return false, stacktrace.RootCause(err)
+	}
+	if err := r.changeMembership(ctx, &membershipChange{
+		Op:      membershipTransfer,
+		ID:      cast.ToString(id),
+		Address: cast.ToString(address),
+	}); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"peer.id":        cast.ToString(id),
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) PageRank(ctx context.Context, nodeTypes []string, relationTypes []string, damping *float64, iterations *int, limit *int, writeProperty string) ([]*model.NodeScore, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	d := analytics.DefaultDamping
+	if damping != nil {
+		d = *damping
+	}
+	var i int
+	if iterations != nil {
+		i = *iterations
+	}
+	results, err := analytics.PageRank(r.graph, analyticsOptions(nodeTypes, relationTypes), d, i)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	if err := r.writeProperty(ctx, writeProperty, scoreValues(results)); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	resp, err := r.toNodeScores(results, limit)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return resp, nil
+}
+
+func (r *mutationResolver) DegreeCentrality(ctx context.Context, nodeTypes []string, relationTypes []string, direction *model.Direction, limit *int, writeProperty string) ([]*model.NodeScore, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	var dir api.Direction
+	if direction != nil {
+		dir = api.Direction(*direction)
+	}
+	results, err := analytics.DegreeCentrality(r.graph, analyticsOptions(nodeTypes, relationTypes), dir)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	if err := r.writeProperty(ctx, writeProperty, scoreValues(results)); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	resp, err := r.toNodeScores(results, limit)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return resp, nil
+}
+
+func (r *mutationResolver) ConnectedComponents(ctx context.Context, nodeTypes []string, relationTypes []string, limit *int, writeProperty string) ([]*model.NodeGroup, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	results, err := analytics.ConnectedComponents(r.graph, analyticsOptions(nodeTypes, relationTypes))
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	if err := r.writeProperty(ctx, writeProperty, groupValues(results)); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	resp, err := r.toNodeGroups(results, limit)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return resp, nil
+}
+
+func (r *mutationResolver) Communities(ctx context.Context, nodeTypes []string, relationTypes []string, iterations *int, limit *int, writeProperty string) ([]*model.NodeGroup, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	var i int
+	if iterations != nil {
+		i = *iterations
+	}
+	results, err := analytics.Communities(r.graph, analyticsOptions(nodeTypes, relationTypes), i)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	if err := r.writeProperty(ctx, writeProperty, groupValues(results)); err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	resp, err := r.toNodeGroups(results, limit)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return resp, nil
+}
+
 func (r *nodeResolver) Properties(ctx context.Context, obj *model.Node) (map[string]interface{}, error) {
 	op := graphql.GetOperationContext(ctx)
 	_, err := r.mw.RequireRole(ctx, config.READER)
@@ -77,9 +675,9 @@ func (r *nodeResolver) GetProperty(ctx context.Context, obj *model.Node, key str
 	return val, nil
 }
 
-func (r *nodeResolver) SetProperties(ctx context.Context, obj *model.Node, properties map[string]interface{}) (bool, error) {
+func (r *nodeResolver) SetProperties(ctx context.Context, obj *model.Node, properties map[string]interface{}, ifVersion *int) (bool, error) {
 	op := graphql.GetOperationContext(ctx)
-	_, err := r.mw.RequireRole(ctx, config.WRITER)
+	_, err := r.requireWriter(ctx)
 	if err != nil {
 		return false, stacktrace.Propagate(err, "")
 	}
@@ -87,12 +685,43 @@ func (r *nodeResolver) SetProperties(ctx context.Context, obj *model.Node, prope
 		Method:     fsm.MethodNodeSetProperties,
 		Properties: properties,
 		Timestamp:  time.Now(),
-		Metadata: map[string]string{
+		Metadata: withIfVersion(map[string]string{
 			"id":   obj.ID,
 			"type": obj.Type,
-		},
+		}, ifVersion),
+	}
+	_, err = r.applyCMD(ctx, cmd)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.type":      obj.Type,
+			"node.id":        obj.ID,
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
+func (r *nodeResolver) ReplaceProperties(ctx context.Context, obj *model.Node, properties map[string]interface{}, ifVersion *int) (bool, error) {
+	return r.SetProperties(ctx, obj, properties, ifVersion)
+}
+
+func (r *nodeResolver) PatchProperties(ctx context.Context, obj *model.Node, patch model.Patch, ifVersion *int) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	cmd := &fsm.CMD{
+		Method:    fsm.MethodNodePatchProperties,
+		Patch:     &patch,
+		Timestamp: time.Now(),
+		Metadata: withIfVersion(map[string]string{
+			"id":   obj.ID,
+			"type": obj.Type,
+		}, ifVersion),
 	}
-	_, err = r.applyCMD(cmd)
+	_, err = r.applyCMD(ctx, cmd)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
@@ -140,7 +769,7 @@ func (r *nodeResolver) GetRelation(ctx context.Context, obj *model.Node, relatio
 
 func (r *nodeResolver) AddRelation(ctx context.Context, obj *model.Node, direction *model.Direction, relation string, properties map[string]interface{}, nodeKey model.Key) (*model.Relation, error) {
 	op := graphql.GetOperationContext(ctx)
-	_, err := r.mw.RequireRole(ctx, config.WRITER)
+	_, err := r.requireWriter(ctx)
 	if err != nil {
 		return nil, stacktrace.RootCause(err)
 	}
@@ -163,7 +792,7 @@ func (r *nodeResolver) AddRelation(ctx context.Context, obj *model.Node, directi
 			"direction":   string(*direction),
 		},
 	}
-	val, err := r.applyCMD(cmd)
+	val, err := r.applyCMD(ctx, cmd)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
@@ -187,7 +816,7 @@ func (r *nodeResolver) AddRelation(ctx context.Context, obj *model.Node, directi
 
 func (r *nodeResolver) DelRelation(ctx context.Context, obj *model.Node, key model.Key) (bool, error) {
 	op := graphql.GetOperationContext(ctx)
-	_, err := r.mw.RequireRole(ctx, config.WRITER)
+	_, err := r.requireWriter(ctx)
 	if err != nil {
 		return false, stacktrace.Propagate(err, "")
 	}
@@ -196,11 +825,11 @@ func (r *nodeResolver) DelRelation(ctx context.Context, obj *model.Node, key mod
 		Key:       key,
 		Timestamp: time.Now(),
 		Metadata: map[string]string{
-			"id":   obj.Type,
-			"type": obj.ID,
+			"id":   obj.ID,
+			"type": obj.Type,
 		},
 	}
-	_, err = r.applyCMD(cmd)
+	_, err = r.applyCMD(ctx, cmd)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
@@ -250,14 +879,27 @@ func (r *nodeResolver) Relations(ctx context.Context, obj *model.Node, where mod
 		}
 		resp = append(resp, i)
 	}
+	var total int
+	if requested(ctx, "totalCount") {
+		total, err = n.CountRelations(&where)
+		if err != nil {
+			logger.L.Error("failed to count relations", stacktrace.Propagate(err, ""), map[string]interface{}{
+				"operation.name": op.OperationName,
+				"node.type":      obj.Type,
+				"node.id":        obj.ID,
+			})
+			return nil, stacktrace.RootCause(err)
+		}
+	}
 	return &model.Relations{
-		Cursor: cursor,
-		Values: resp,
+		Cursor:     cursor,
+		Values:     resp,
+		TotalCount: total,
 	}, nil
 }
 
 func (r *nodeResolver) AddIncomingNode(ctx context.Context, obj *model.Node, relation string, properties map[string]interface{}, addNode model.AddNode) (*model.Node, error) {
-	n, err := r.Query().Add(ctx, addNode)
+	n, err := r.Mutation().Add(ctx, addNode)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"node.type":     obj.Type,
@@ -277,7 +919,7 @@ func (r *nodeResolver) AddIncomingNode(ctx context.Context, obj *model.Node, rel
 }
 
 func (r *nodeResolver) AddOutboundNode(ctx context.Context, obj *model.Node, relation string, properties map[string]interface{}, addNode model.AddNode) (*model.Node, error) {
-	n, err := r.Query().Add(ctx, addNode)
+	n, err := r.Mutation().Add(ctx, addNode)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"node.type":     obj.Type,
@@ -301,7 +943,7 @@ func (r *nodesResolver) Agg(ctx context.Context, obj *model.Nodes, fn model.Aggr
 	case model.AggregateFunctionSum:
 		sum := float64(0)
 		for _, n := range obj.Values {
-			sum += cast.ToFloat64(n.Properties[field])
+			sum += cast.ToFloat64(helpers.Lookup(n.Properties, field))
 		}
 		return sum, nil
 	case model.AggregateFunctionCount:
@@ -310,8 +952,7 @@ func (r *nodesResolver) Agg(ctx context.Context, obj *model.Nodes, fn model.Aggr
 		}
 		count := 0
 		for _, n := range obj.Values {
-			_, ok := n.Properties[field]
-			if ok {
+			if helpers.Lookup(n.Properties, field) != nil {
 				count++
 			}
 		}
@@ -320,7 +961,7 @@ func (r *nodesResolver) Agg(ctx context.Context, obj *model.Nodes, fn model.Aggr
 	case model.AggregateFunctionAvg:
 		sum := float64(0)
 		for _, n := range obj.Values {
-			sum += cast.ToFloat64(n.Properties[field])
+			sum += cast.ToFloat64(helpers.Lookup(n.Properties, field))
 		}
 		if sum == 0 {
 			return 0, nil
@@ -329,7 +970,7 @@ func (r *nodesResolver) Agg(ctx context.Context, obj *model.Nodes, fn model.Aggr
 	case model.AggregateFunctionMax:
 		max := float64(0)
 		for _, n := range obj.Values {
-			if field := cast.ToFloat64(n.Properties[field]); field > max {
+			if field := cast.ToFloat64(helpers.Lookup(n.Properties, field)); field > max {
 				max = field
 			}
 		}
@@ -338,282 +979,518 @@ func (r *nodesResolver) Agg(ctx context.Context, obj *model.Nodes, fn model.Aggr
 		if len(obj.Values) == 0 {
 			return 0, nil
 		}
-		min := cast.ToFloat64(obj.Values[0].Properties[field])
+		min := cast.ToFloat64(helpers.Lookup(obj.Values[0].Properties, field))
 		for _, n := range obj.Values {
-			if field := cast.ToFloat64(n.Properties[field]); field < min {
+			if field := cast.ToFloat64(helpers.Lookup(n.Properties, field)); field < min {
 				min = field
 			}
 		}
 		return min, nil
+	case model.AggregateFunctionCountDistinct, model.AggregateFunctionPercentile, model.AggregateFunctionHistogram:
+		return 0, stacktrace.NewError("%s is only supported by the aggregate query", fn)
+	}
+	return 0, nil
+}
+
+func (r *queryResolver) Types(ctx context.Context) ([]string, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.READER)
+	if err != nil {
+		logger.L.Error("failed to list relations", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return r.graph.NodeTypes(), nil
+}
+
+func (r *queryResolver) Schema(ctx context.Context) ([]*model.NodeSchema, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.READER)
+	if err != nil {
+		logger.L.Error("failed to get schema", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return r.graph.Schema(), nil
+}
+
+func (r *queryResolver) NodeDefinitions(ctx context.Context) ([]*model.TypeDefinition, error) {
+	_, err := r.mw.RequireRole(ctx, config.READER)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	return r.graph.NodeDefinitions(), nil
+}
+
+func (r *queryResolver) RelationDefinitions(ctx context.Context) ([]*model.TypeDefinition, error) {
+	_, err := r.mw.RequireRole(ctx, config.READER)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	return r.graph.RelationDefinitions(), nil
+}
+
+func (r *queryResolver) Get(ctx context.Context, key model.Key) (*model.Node, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.READER)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	n, err := r.graph.GetNode(key.Type, key.ID)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.type":      key.Type,
+			"node.id":        key.ID,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	node, err := toNode(n)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.type":      key.Type,
+			"node.id":        key.ID,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return node, nil
+}
+
+func (r *queryResolver) List(ctx context.Context, where model.NodeWhere) (*model.Nodes, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.READER)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	cursor, nodes, err := r.graph.RangeNodes(&where)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"where.type":     where.Type,
+			"where.cursor":   where.Cursor,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	var resp = &model.Nodes{Cursor: cursor}
+	for _, node := range nodes {
+		n, err := toNode(node)
+		if err != nil {
+			logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+				"operation.name": op.OperationName,
+				"where.type":     where.Type,
+				"where.cursor":   where.Cursor,
+			})
+			return nil, stacktrace.RootCause(err)
+		}
+		resp.Values = append(resp.Values, n)
+	}
+	if requested(ctx, "totalCount") {
+		resp.TotalCount, err = r.graph.CountNodes(&where)
+		if err != nil {
+			logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+				"operation.name": op.OperationName,
+				"where.type":     where.Type,
+			})
+			return nil, stacktrace.RootCause(err)
+		}
+	}
+	return resp, nil
+}
+
+func (r *queryResolver) Count(ctx context.Context, where model.NodeWhere) (int, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.READER)
+	if err != nil {
+		return 0, stacktrace.RootCause(err)
 	}
-	return 0, nil
+	if err := r.awaitConsistency(ctx); err != nil {
+		return 0, stacktrace.RootCause(err)
+	}
+	count, err := r.graph.CountNodes(&where)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"where.type":     where.Type,
+		})
+		return 0, stacktrace.RootCause(err)
+	}
+	return count, nil
 }
 
-func (r *queryResolver) Types(ctx context.Context) ([]string, error) {
+func (r *queryResolver) Traverse(ctx context.Context, start model.Key, relations []string, direction *model.Direction, algorithm *model.TraverseAlgorithm, minDepth *int, maxDepth *int, nodeFilter []*model.Expression, relationFilter []*model.Expression, limit *int) ([]*model.Traversal, error) {
 	op := graphql.GetOperationContext(ctx)
 	_, err := r.mw.RequireRole(ctx, config.READER)
 	if err != nil {
-		logger.L.Error("failed to list relations", stacktrace.Propagate(err, ""), map[string]interface{}{
+		return nil, stacktrace.RootCause(err)
+	}
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	opts := &api.TraverseOptions{
+		Start:          start,
+		Relations:      relations,
+		Algorithm:      model.TraverseAlgorithmBfs,
+		MinDepth:       1,
+		MaxDepth:       1,
+		NodeFilter:     nodeFilter,
+		RelationFilter: relationFilter,
+	}
+	if direction != nil {
+		opts.Direction = api.Direction(*direction)
+	}
+	if algorithm != nil {
+		opts.Algorithm = *algorithm
+	}
+	if minDepth != nil {
+		opts.MinDepth = *minDepth
+	}
+	if maxDepth != nil {
+		opts.MaxDepth = *maxDepth
+	}
+	if limit != nil {
+		opts.Limit = *limit
+	}
+	traversals, err := r.graph.Traverse(opts)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
+			"start.type":     start.Type,
+			"start.id":       start.ID,
 		})
 		return nil, stacktrace.RootCause(err)
 	}
-	return r.graph.NodeTypes(), nil
+	// paths are only loaded if they're selected because each relation in a path loads its source & target
+	var withPath bool
+	for _, field := range graphql.CollectAllFields(ctx) {
+		if field == "path" {
+			withPath = true
+		}
+	}
+	var resp []*model.Traversal
+	for _, traversal := range traversals {
+		n, err := toNode(traversal.Node)
+		if err != nil {
+			logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+				"operation.name": op.OperationName,
+				"start.type":     start.Type,
+				"start.id":       start.ID,
+			})
+			return nil, stacktrace.RootCause(err)
+		}
+		t := &model.Traversal{Node: n, Depth: traversal.Depth}
+		if withPath {
+			for _, rel := range traversal.Path {
+				relation, err := toRelation(rel)
+				if err != nil {
+					logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+						"operation.name": op.OperationName,
+						"start.type":     start.Type,
+						"start.id":       start.ID,
+					})
+					return nil, stacktrace.RootCause(err)
+				}
+				t.Path = append(t.Path, relation)
+			}
+		}
+		resp = append(resp, t)
+	}
+	return resp, nil
 }
 
-func (r *queryResolver) Get(ctx context.Context, key model.Key) (*model.Node, error) {
+func (r *queryResolver) ShortestPath(ctx context.Context, from model.Key, to model.Key, relations []string, direction *model.Direction, maxDepth *int) (*model.Path, error) {
 	op := graphql.GetOperationContext(ctx)
 	_, err := r.mw.RequireRole(ctx, config.READER)
 	if err != nil {
 		return nil, stacktrace.RootCause(err)
 	}
-	n, err := r.graph.GetNode(key.Type, key.ID)
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	opts := &api.PathOptions{
+		From:      from,
+		To:        to,
+		Relations: relations,
+	}
+	if direction != nil {
+		opts.Direction = api.Direction(*direction)
+	}
+	if maxDepth != nil {
+		opts.MaxDepth = *maxDepth
+	}
+	path, err := r.graph.ShortestPath(opts)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
-			"node.type":      key.Type,
-			"node.id":        key.ID,
+			"from.type":      from.Type,
+			"from.id":        from.ID,
+			"to.type":        to.Type,
+			"to.id":          to.ID,
 		})
 		return nil, stacktrace.RootCause(err)
 	}
-	node, err := toNode(n)
+	if path == nil {
+		return nil, nil
+	}
+	resp, err := toPath(path)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
-			"node.type":      key.Type,
-			"node.id":        key.ID,
+			"from.type":      from.Type,
+			"from.id":        from.ID,
+			"to.type":        to.Type,
+			"to.id":          to.ID,
 		})
 		return nil, stacktrace.RootCause(err)
 	}
-	return node, nil
+	return resp, nil
 }
 
-func (r *queryResolver) List(ctx context.Context, where model.NodeWhere) (*model.Nodes, error) {
+func (r *queryResolver) WeightedPath(ctx context.Context, from model.Key, to model.Key, relations []string, direction *model.Direction, maxDepth *int, weightField string) (*model.Path, error) {
 	op := graphql.GetOperationContext(ctx)
 	_, err := r.mw.RequireRole(ctx, config.READER)
 	if err != nil {
 		return nil, stacktrace.RootCause(err)
 	}
-	cursor, nodes, err := r.graph.RangeNodes(&where)
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	opts := &api.PathOptions{
+		From:        from,
+		To:          to,
+		Relations:   relations,
+		WeightField: weightField,
+	}
+	if direction != nil {
+		opts.Direction = api.Direction(*direction)
+	}
+	if maxDepth != nil {
+		opts.MaxDepth = *maxDepth
+	}
+	path, err := r.graph.WeightedPath(opts)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
-			"where.type":     where.Type,
-			"where.cursor":   where.Cursor,
+			"from.type":      from.Type,
+			"from.id":        from.ID,
+			"to.type":        to.Type,
+			"to.id":          to.ID,
 		})
 		return nil, stacktrace.RootCause(err)
 	}
-	var resp = &model.Nodes{Cursor: cursor}
-	for _, node := range nodes {
-		n, err := toNode(node)
-		if err != nil {
-			logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
-				"operation.name": op.OperationName,
-				"where.type":     where.Type,
-				"where.cursor":   where.Cursor,
-			})
-			return nil, stacktrace.RootCause(err)
-		}
-		resp.Values = append(resp.Values, n)
+	if path == nil {
+		return nil, nil
+	}
+	resp, err := toPath(path)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"from.type":      from.Type,
+			"from.id":        from.ID,
+			"to.type":        to.Type,
+			"to.id":          to.ID,
+		})
+		return nil, stacktrace.RootCause(err)
 	}
 	return resp, nil
 }
 
-func (r *queryResolver) Add(ctx context.Context, add model.AddNode) (*model.Node, error) {
+func (r *queryResolver) PageRank(ctx context.Context, nodeTypes []string, relationTypes []string, damping *float64, iterations *int, limit *int) ([]*model.NodeScore, error) {
 	op := graphql.GetOperationContext(ctx)
-	_, err := r.mw.RequireRole(ctx, config.WRITER)
+	_, err := r.mw.RequireRole(ctx, config.READER)
 	if err != nil {
 		return nil, stacktrace.RootCause(err)
 	}
-	a := &add
-	if a.ID == nil {
-		id := uuid.New().String()
-		a.ID = &id
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
 	}
-	cmd := &fsm.CMD{
-		Method: fsm.MethodAdd,
-		Node: model.Node{
-			ID:         *a.ID,
-			Type:       a.Type,
-			Properties: a.Properties,
-		},
-		Timestamp: time.Now(),
-		Metadata:  nil,
+	d := analytics.DefaultDamping
+	if damping != nil {
+		d = *damping
+	}
+	var i int
+	if iterations != nil {
+		i = *iterations
 	}
-	result, err := r.applyCMD(cmd)
+	results, err := analytics.PageRank(r.graph, analyticsOptions(nodeTypes, relationTypes), d, i)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
-			"node.id":        *add.ID,
-			"node.type":      add.Type,
 		})
 		return nil, stacktrace.RootCause(err)
 	}
-	node, err := toNode(result.(api.Node))
+	resp, err := r.toNodeScores(results, limit)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
-			"node.id":        *add.ID,
-			"node.type":      add.Type,
 		})
 		return nil, stacktrace.RootCause(err)
 	}
-	return node, nil
+	return resp, nil
 }
 
-func (r *queryResolver) Set(ctx context.Context, set model.SetNode) (*model.Node, error) {
+func (r *queryResolver) DegreeCentrality(ctx context.Context, nodeTypes []string, relationTypes []string, direction *model.Direction, limit *int) ([]*model.NodeScore, error) {
 	op := graphql.GetOperationContext(ctx)
-	_, err := r.mw.RequireRole(ctx, config.WRITER)
+	_, err := r.mw.RequireRole(ctx, config.READER)
 	if err != nil {
 		return nil, stacktrace.RootCause(err)
 	}
-	cmd := &fsm.CMD{
-		Method: fsm.MethodSet,
-		Node: model.Node{
-			ID:         set.ID,
-			Type:       set.Type,
-			Properties: set.Properties,
-		},
-		Timestamp: time.Now(),
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	var dir api.Direction
+	if direction != nil {
+		dir = api.Direction(*direction)
 	}
-	result, err := r.applyCMD(cmd)
+	results, err := analytics.DegreeCentrality(r.graph, analyticsOptions(nodeTypes, relationTypes), dir)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
-			"node.id":        set.ID,
-			"node.type":      set.Type,
 		})
 		return nil, stacktrace.RootCause(err)
 	}
-	n, err := toNode(result.(api.Node))
+	resp, err := r.toNodeScores(results, limit)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
-			"node.id":        set.ID,
-			"node.type":      set.Type,
 		})
 		return nil, stacktrace.RootCause(err)
 	}
-	return n, nil
+	return resp, nil
 }
 
-func (r *queryResolver) Del(ctx context.Context, del model.Key) (bool, error) {
+func (r *queryResolver) ConnectedComponents(ctx context.Context, nodeTypes []string, relationTypes []string, limit *int) ([]*model.NodeGroup, error) {
 	op := graphql.GetOperationContext(ctx)
-	_, err := r.mw.RequireRole(ctx, config.WRITER)
+	_, err := r.mw.RequireRole(ctx, config.READER)
 	if err != nil {
-		return false, stacktrace.Propagate(err, "")
+		return nil, stacktrace.RootCause(err)
 	}
-	cmd := &fsm.CMD{
-		Method:    fsm.MethodDel,
-		Key:       del,
-		Timestamp: time.Now(),
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
 	}
-	_, err = r.applyCMD(cmd)
+	results, err := analytics.ConnectedComponents(r.graph, analyticsOptions(nodeTypes, relationTypes))
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
-			"node.id":        del.ID,
-			"node.type":      del.Type,
 		})
-		return false, stacktrace.RootCause(err)
+		return nil, stacktrace.RootCause(err)
 	}
-	return true, nil
+	resp, err := r.toNodeGroups(results, limit)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return resp, nil
 }
 
-func (r *queryResolver) BulkAdd(ctx context.Context, add []*model.AddNode) (bool, error) {
+func (r *queryResolver) Communities(ctx context.Context, nodeTypes []string, relationTypes []string, iterations *int, limit *int) ([]*model.NodeGroup, error) {
 	op := graphql.GetOperationContext(ctx)
-	_, err := r.mw.RequireRole(ctx, config.WRITER)
+	_, err := r.mw.RequireRole(ctx, config.READER)
 	if err != nil {
-		return false, stacktrace.Propagate(err, "")
+		return nil, stacktrace.RootCause(err)
 	}
-
-	for _, a := range add {
-		if a.ID == nil {
-			id := uuid.New().String()
-			a.ID = &id
-		}
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
 	}
-	cmd := &fsm.CMD{
-		Method:    fsm.MethodBulkAdd,
-		AddNodes:  add,
-		Timestamp: time.Now(),
-		Metadata:  nil,
+	var i int
+	if iterations != nil {
+		i = *iterations
 	}
-	_, err = r.applyCMD(cmd)
+	results, err := analytics.Communities(r.graph, analyticsOptions(nodeTypes, relationTypes), i)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
 		})
-		return false, stacktrace.RootCause(err)
+		return nil, stacktrace.RootCause(err)
 	}
-	return true, nil
+	resp, err := r.toNodeGroups(results, limit)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return resp, nil
 }
 
-func (r *queryResolver) BulkSet(ctx context.Context, set []*model.SetNode) (bool, error) {
+func (r *queryResolver) Cypher(ctx context.Context, query string, params map[string]interface{}) (*model.CypherResult, error) {
 	op := graphql.GetOperationContext(ctx)
-	_, err := r.mw.RequireRole(ctx, config.WRITER)
+	_, err := r.mw.RequireRole(ctx, config.READER)
 	if err != nil {
-		return false, stacktrace.Propagate(err, "")
+		return nil, stacktrace.RootCause(err)
 	}
-	cmd := &fsm.CMD{
-		Method:    fsm.MethodBulkSet,
-		SetNodes:  set,
-		Timestamp: time.Now(),
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
 	}
-	_, err = r.applyCMD(cmd)
+	result, err := cypher.Query(r.graph, query, params)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
+			"query":          query,
 		})
-		return false, stacktrace.RootCause(err)
+		return nil, stacktrace.RootCause(err)
 	}
-	return true, nil
+	return &model.CypherResult{
+		Columns: result.Columns,
+		Rows:    result.Rows,
+	}, nil
 }
 
-func (r *queryResolver) BulkDel(ctx context.Context, del []*model.Key) (bool, error) {
+func (r *queryResolver) Aggregate(ctx context.Context, typeArg string, where *model.Filter, groupBy []string, metrics []*model.Metric) ([]*model.AggregateRow, error) {
 	op := graphql.GetOperationContext(ctx)
-	_, err := r.mw.RequireRole(ctx, config.WRITER)
+	_, err := r.mw.RequireRole(ctx, config.READER)
 	if err != nil {
-		return false, stacktrace.Propagate(err, "")
+		return nil, stacktrace.RootCause(err)
 	}
-	cmd := &fsm.CMD{
-		Method:    fsm.MethodBulkDel,
-		Keys:      del,
-		Timestamp: time.Now(),
-		Metadata:  map[string]string{},
+	if err := r.awaitConsistency(ctx); err != nil {
+		return nil, stacktrace.RootCause(err)
 	}
-	_, err = r.applyCMD(cmd)
+	rows, err := r.graph.Aggregate(&api.AggregateOptions{
+		Type:    typeArg,
+		Filter:  where,
+		GroupBy: groupBy,
+		Metrics: metrics,
+	})
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
+			"type":           typeArg,
 		})
-		return false, stacktrace.RootCause(err)
+		return nil, stacktrace.RootCause(err)
 	}
-	return true, nil
+	return rows, nil
 }
 
-func (r *queryResolver) Login(ctx context.Context, username string, password string) (string, error) {
+func (r *queryResolver) Cluster(ctx context.Context) (*model.ClusterStatus, error) {
 	op := graphql.GetOperationContext(ctx)
-	token, err := r.mw.Login(username, password)
+	_, err := r.mw.RequireRole(ctx, config.ADMIN)
 	if err != nil {
-		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
-			"operation.name": op.OperationName,
-			"username":       username,
-		})
-		return "", stacktrace.RootCause(err)
+		return nil, stacktrace.RootCause(err)
 	}
-	expired, _, err := helpers.JWTExpired(token)
+	status, err := r.clusterStatus()
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"operation.name": op.OperationName,
-			"username":       username,
 		})
-		return "", stacktrace.RootCause(err)
-	}
-	if expired {
-		return "", fmt.Errorf("expired jwt (internal): %s", token)
+		return nil, stacktrace.RootCause(err)
 	}
-	return token, nil
+	return status, nil
 }
 
 func (r *relationResolver) Properties(ctx context.Context, obj *model.Relation) (map[string]interface{}, error) {
@@ -670,9 +1547,9 @@ func (r *relationResolver) GetProperty(ctx context.Context, obj *model.Relation,
 	return val, nil
 }
 
-func (r *relationResolver) SetProperties(ctx context.Context, obj *model.Relation, properties map[string]interface{}) (bool, error) {
+func (r *relationResolver) SetProperties(ctx context.Context, obj *model.Relation, properties map[string]interface{}, ifVersion *int) (bool, error) {
 	op := graphql.GetOperationContext(ctx)
-	_, err := r.mw.RequireRole(ctx, config.WRITER)
+	_, err := r.requireWriter(ctx)
 	if err != nil {
 		return false, stacktrace.Propagate(err, "")
 	}
@@ -680,12 +1557,12 @@ func (r *relationResolver) SetProperties(ctx context.Context, obj *model.Relatio
 		Method:     fsm.MethodRelationSetProperties,
 		Properties: properties,
 		Timestamp:  time.Now(),
-		Metadata: map[string]string{
+		Metadata: withIfVersion(map[string]string{
 			"id":   obj.ID,
 			"type": obj.Type,
-		},
+		}, ifVersion),
 	}
-	_, err = r.applyCMD(cmd)
+	_, err = r.applyCMD(ctx, cmd)
 	if err != nil {
 		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
 			"error":          stacktrace.Propagate(err, ""),
@@ -698,6 +1575,37 @@ func (r *relationResolver) SetProperties(ctx context.Context, obj *model.Relatio
 	return true, nil
 }
 
+func (r *relationResolver) ReplaceProperties(ctx context.Context, obj *model.Relation, properties map[string]interface{}, ifVersion *int) (bool, error) {
+	return r.SetProperties(ctx, obj, properties, ifVersion)
+}
+
+func (r *relationResolver) PatchProperties(ctx context.Context, obj *model.Relation, patch model.Patch, ifVersion *int) (bool, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.requireWriter(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	cmd := &fsm.CMD{
+		Method:    fsm.MethodRelationPatchProperties,
+		Patch:     &patch,
+		Timestamp: time.Now(),
+		Metadata: withIfVersion(map[string]string{
+			"id":   obj.ID,
+			"type": obj.Type,
+		}, ifVersion),
+	}
+	_, err = r.applyCMD(ctx, cmd)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"relation.type":  obj.Type,
+			"relation.id":    obj.ID,
+		})
+		return false, stacktrace.RootCause(err)
+	}
+	return true, nil
+}
+
 func (r *relationsResolver) Agg(ctx context.Context, obj *model.Relations, fn model.AggregateFunction, field string) (float64, error) {
 	if strings.Contains(field, "_target.") {
 		var values []*model.Node
@@ -763,10 +1671,49 @@ func (r *relationsResolver) Agg(ctx context.Context, obj *model.Relations, fn mo
 			}
 		}
 		return min, nil
+	case model.AggregateFunctionCountDistinct, model.AggregateFunctionPercentile, model.AggregateFunctionHistogram:
+		return 0, stacktrace.NewError("%s is only supported by the aggregate query", fn)
 	}
 	return 0, nil
 }
 
+func (r *subscriptionResolver) NodeChanged(ctx context.Context, typeArg string, expressions []*model.Expression) (<-chan *model.NodeChange, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.READER)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	changes, err := r.graph.SubscribeNodes(ctx, typeArg, expressions)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"node.type":      typeArg,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return changes, nil
+}
+
+func (r *subscriptionResolver) RelationChanged(ctx context.Context, relation string, expressions []*model.Expression) (<-chan *model.RelationChange, error) {
+	op := graphql.GetOperationContext(ctx)
+	_, err := r.mw.RequireRole(ctx, config.READER)
+	if err != nil {
+		return nil, stacktrace.RootCause(err)
+	}
+	changes, err := r.graph.SubscribeRelations(ctx, relation, expressions)
+	if err != nil {
+		logger.L.Error("graphql resolver error", stacktrace.Propagate(err, ""), map[string]interface{}{
+			"operation.name": op.OperationName,
+			"relation.type":  relation,
+		})
+		return nil, stacktrace.RootCause(err)
+	}
+	return changes, nil
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
 // Node returns generated.NodeResolver implementation.
 func (r *Resolver) Node() generated.NodeResolver { return &nodeResolver{r} }
 
@@ -782,8 +1729,13 @@ func (r *Resolver) Relation() generated.RelationResolver { return &relationResol
 // Relations returns generated.RelationsResolver implementation.
 func (r *Resolver) Relations() generated.RelationsResolver { return &relationsResolver{r} }
 
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type mutationResolver struct{ *Resolver }
 type nodeResolver struct{ *Resolver }
 type nodesResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type relationResolver struct{ *Resolver }
 type relationsResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"github.com/palantir/stacktrace"
 	"github.com/spf13/cast"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -49,3 +51,48 @@ func JWTExpired(token string) (bool, int64, error) {
 	}
 	return true, expUnix, nil
 }
+
+// Lookup returns the value at the dotted path(ex: address.city) of the properties or nil if it doesn't exist. Numeric
+// segments index into arrays, other segments are looked up in every element of an array & return the values found.
+func Lookup(properties map[string]interface{}, path string) interface{} {
+	if val, ok := properties[path]; ok || !strings.Contains(path, ".") {
+		return val
+	}
+	return lookup(properties, strings.Split(path, "."))
+}
+
+func lookup(value interface{}, path []string) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		elem := rv.MapIndex(reflect.ValueOf(path[0]).Convert(rv.Type().Key()))
+		if !elem.IsValid() {
+			return nil
+		}
+		return lookup(elem.Interface(), path[1:])
+	case reflect.Slice, reflect.Array:
+		if i, err := strconv.Atoi(path[0]); err == nil {
+			if i < 0 || i >= rv.Len() {
+				return nil
+			}
+			return lookup(rv.Index(i).Interface(), path[1:])
+		}
+		var values []interface{}
+		for i := 0; i < rv.Len(); i++ {
+			if val := lookup(rv.Index(i).Interface(), path); val != nil {
+				values = append(values, val)
+			}
+		}
+		if len(values) == 0 {
+			return nil
+		}
+		return values
+	}
+	return nil
+}
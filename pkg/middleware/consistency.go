@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"github.com/palantir/stacktrace"
+	"net/http"
+	"strings"
+)
+
+// Consistency is the consistency level of the reads within a request
+type Consistency string
+
+const (
+	// ConsistencyStale reads from the local store which may lag behind the leader
+	ConsistencyStale Consistency = "STALE"
+	// ConsistencyLeader reads from the store of the leader
+	ConsistencyLeader Consistency = "LEADER"
+	// ConsistencyLinearizable reads from the local store once it has applied every command committed before the read
+	ConsistencyLinearizable Consistency = "LINEARIZABLE"
+)
+
+// ConsistencyHeader is the request header that selects the consistency level of reads
+const ConsistencyHeader = "X-Morpheus-Consistency"
+
+const consistencyCtxKey = "consistency_ctx_key"
+
+// ParseConsistency parses a consistency level, defaulting to STALE if it's empty
+func ParseConsistency(value string) (Consistency, error) {
+	switch c := Consistency(strings.ToUpper(strings.TrimSpace(value))); c {
+	case "":
+		return ConsistencyStale, nil
+	case ConsistencyStale, ConsistencyLeader, ConsistencyLinearizable:
+		return c, nil
+	}
+	return "", stacktrace.NewErrorWithCode(http.StatusBadRequest, "unsupported consistency level: %s", value)
+}
+
+func GetConsistencyCtx(ctx context.Context) Consistency {
+	val, ok := ctx.Value(consistencyCtxKey).(Consistency)
+	if !ok {
+		return ConsistencyStale
+	}
+	return val
+}
+
+func withConsistency(ctx context.Context, consistency Consistency) context.Context {
+	return context.WithValue(ctx, consistencyCtxKey, consistency)
+}
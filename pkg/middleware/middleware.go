@@ -2,8 +2,10 @@ package middleware
 
 import (
 	"context"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/autom8ter/morpheus/pkg/config"
 	"github.com/autom8ter/morpheus/pkg/logger"
+	"github.com/palantir/stacktrace"
 	"net/http"
 	"strings"
 	"time"
@@ -39,23 +41,44 @@ func (m *Middleware) Wrap(handler http.Handler) http.Handler {
 			logger.L.Info("http request/response", logFields)
 		}()
 
-		if token == "" {
-			handler.ServeHTTP(w, req.WithContext(ctx))
-			return
-		}
-		ctx = context.WithValue(ctx, tokenCtxKey, token)
-		claims, err := m.parseClaims(token)
+		consistency, err := ParseConsistency(req.Header.Get(ConsistencyHeader))
 		if err != nil {
-			logger.L.Error("failed to parse Authorization token", err, map[string]interface{}{})
+			http.Error(w, stacktrace.RootCause(err).Error(), http.StatusBadRequest)
+			return
 		}
-		if claims != nil {
-			for _, usr := range m.config.Auth.Users {
-				if usr.Username == claims["sub"] {
-					logFields["user"] = usr.Username
-					ctx = context.WithValue(ctx, userCtxKey, usr)
-				}
+		ctx = withConsistency(ctx, consistency)
+		if token != "" {
+			var usr config.User
+			ctx, usr = m.withToken(ctx, token)
+			if usr.Username != "" {
+				logFields["user"] = usr.Username
 			}
 		}
 		handler.ServeHTTP(w, req.WithContext(ctx))
 	})
 }
+
+// WebsocketInit authenticates websocket connections using the Authorization field of the connection init payload
+func (m *Middleware) WebsocketInit(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+	token := strings.TrimPrefix(payload.Authorization(), "Bearer ")
+	if token == "" {
+		return ctx, nil
+	}
+	ctx, _ = m.withToken(ctx, token)
+	return ctx, nil
+}
+
+func (m *Middleware) withToken(ctx context.Context, token string) (context.Context, config.User) {
+	ctx = context.WithValue(ctx, tokenCtxKey, token)
+	claims, err := m.parseClaims(token)
+	if err != nil {
+		logger.L.Error("failed to parse Authorization token", err, map[string]interface{}{})
+		return ctx, config.User{}
+	}
+	for _, usr := range m.config.Auth.Users {
+		if usr.Username == claims["sub"] {
+			return context.WithValue(ctx, userCtxKey, usr), usr
+		}
+	}
+	return ctx, config.User{}
+}
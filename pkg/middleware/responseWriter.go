@@ -1,7 +1,10 @@
 package middleware
 
 import (
+	"bufio"
 	"bytes"
+	"github.com/palantir/stacktrace"
+	"net"
 	"net/http"
 )
 
@@ -32,3 +35,12 @@ func (i *responseWriterWrapper) Body() string {
 func (i *responseWriterWrapper) StatusCode() int {
 	return i.statusCode
 }
+
+// Hijack allows the websocket transport to take over the underlying connection
+func (i *responseWriterWrapper) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := i.w.(http.Hijacker)
+	if !ok {
+		return nil, nil, stacktrace.NewError("response writer does not implement http.Hijacker")
+	}
+	return hijacker.Hijack()
+}
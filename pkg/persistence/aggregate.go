@@ -0,0 +1,263 @@
+package persistence
+
+import (
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/palantir/stacktrace"
+	"math"
+	"sort"
+	"strings"
+)
+
+const defaultPercentile = 50
+
+type aggregateGroup struct {
+	key     string
+	values  map[string]interface{}
+	metrics []*metricState
+}
+
+// metricState accumulates the values of a metric's field. Array properties contribute each of their elements.
+type metricState struct {
+	metric   *model.Metric
+	field    string
+	count    int
+	numbers  int
+	sum      float64
+	min      float64
+	max      float64
+	values   []float64
+	distinct map[string]struct{}
+	buckets  map[float64]int
+}
+
+func (d *DB) Aggregate(opts *api.AggregateOptions) ([]*model.AggregateRow, error) {
+	if err := validateMetrics(opts.Metrics); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	var (
+		where  = &model.NodeWhere{Type: opts.Type, Filter: opts.Filter}
+		kr     = nodeRange(where)
+		groups = map[string]*aggregateGroup{}
+	)
+	regexes, err := compileRegexes(nil, opts.Filter)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if err := d.db.View(func(txn *badger.Txn) error {
+		_, err := kr.iterate(txn, nil, func(i int, item *badger.Item) (bool, error) {
+			n, err := d.itemNode(opts.Type, item)
+			if err != nil {
+				return false, stacktrace.Propagate(err, "")
+			}
+			passed, err := evalFilter(opts.Filter, n, regexes)
+			if err != nil {
+				return false, stacktrace.Propagate(err, "")
+			}
+			if passed {
+				duplicate, err := kr.duplicate(i, item.Key(), n)
+				if err != nil {
+					return false, stacktrace.Propagate(err, "")
+				}
+				passed = !duplicate
+			}
+			if !passed {
+				return true, nil
+			}
+			group, err := groupOf(groups, opts, n)
+			if err != nil {
+				return false, stacktrace.Propagate(err, "")
+			}
+			for _, m := range group.metrics {
+				if err := m.add(n); err != nil {
+					return false, stacktrace.Propagate(err, "")
+				}
+			}
+			return true, nil
+		})
+		return err
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	// like SQL, aggregating no nodes without grouping them returns a single row
+	if len(groups) == 0 && len(opts.GroupBy) == 0 {
+		groups[""] = newAggregateGroup("", map[string]interface{}{}, opts.Metrics)
+	}
+	var sorted []*aggregateGroup
+	for _, group := range groups {
+		sorted = append(sorted, group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].key < sorted[j].key
+	})
+	var rows []*model.AggregateRow
+	for _, group := range sorted {
+		row := &model.AggregateRow{Group: group.values}
+		for _, m := range group.metrics {
+			row.Metrics = append(row.Metrics, m.result())
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func validateMetrics(metrics []*model.Metric) error {
+	for _, metric := range metrics {
+		if !metric.Fn.IsValid() {
+			return stacktrace.NewError("unsupported aggregate function: %s", metric.Fn)
+		}
+		if metric.Fn != model.AggregateFunctionCount && (metric.Field == nil || *metric.Field == "") {
+			return stacktrace.NewError("%s requires a field", metric.Fn)
+		}
+		if metric.Fn == model.AggregateFunctionPercentile && metric.Percentile != nil && (*metric.Percentile < 0 || *metric.Percentile > 100) {
+			return stacktrace.NewError("%s requires a percentile between 0 & 100", metric.Fn)
+		}
+		if metric.Fn == model.AggregateFunctionHistogram && (metric.BucketSize == nil || *metric.BucketSize <= 0) {
+			return stacktrace.NewError("%s requires a positive bucket size", metric.Fn)
+		}
+	}
+	return nil
+}
+
+// groupOf returns the group of the node, creating it if it's the first node in the group. Nodes are grouped by the
+// index encoding of their group by values, so numbers group together regardless of their type.
+func groupOf(groups map[string]*aggregateGroup, opts *api.AggregateOptions, n api.Node) (*aggregateGroup, error) {
+	var (
+		keys   = make([]string, len(opts.GroupBy))
+		values = make(map[string]interface{}, len(opts.GroupBy))
+	)
+	for i, field := range opts.GroupBy {
+		val, err := n.GetProperty(field)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		keys[i] = string(encodeIndexValue(val))
+		values[field] = val
+	}
+	key := strings.Join(keys, ",")
+	group, ok := groups[key]
+	if !ok {
+		group = newAggregateGroup(key, values, opts.Metrics)
+		groups[key] = group
+	}
+	return group, nil
+}
+
+func newAggregateGroup(key string, values map[string]interface{}, metrics []*model.Metric) *aggregateGroup {
+	group := &aggregateGroup{key: key, values: values}
+	for _, metric := range metrics {
+		m := &metricState{
+			metric:   metric,
+			distinct: map[string]struct{}{},
+			buckets:  map[float64]int{},
+		}
+		if metric.Field != nil {
+			m.field = *metric.Field
+		}
+		group.metrics = append(group.metrics, m)
+	}
+	return group
+}
+
+func (m *metricState) add(n api.Node) error {
+	if m.field == "" || m.field == "*" {
+		m.count++
+		return nil
+	}
+	val, err := n.GetProperty(m.field)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	var found bool
+	fieldIndexValues(m.field, val, func(field string, value interface{}) {
+		if value == nil {
+			return
+		}
+		found = true
+		if m.metric.Fn == model.AggregateFunctionCountDistinct {
+			m.distinct[string(encodeIndexValue(value))] = struct{}{}
+			return
+		}
+		f, ok := toFloat(value)
+		if !ok {
+			return
+		}
+		if m.numbers == 0 || f < m.min {
+			m.min = f
+		}
+		if m.numbers == 0 || f > m.max {
+			m.max = f
+		}
+		m.numbers++
+		m.sum += f
+		switch m.metric.Fn {
+		case model.AggregateFunctionPercentile:
+			m.values = append(m.values, f)
+		case model.AggregateFunctionHistogram:
+			m.buckets[math.Floor(f / *m.metric.BucketSize)**m.metric.BucketSize]++
+		}
+	})
+	if found {
+		m.count++
+	}
+	return nil
+}
+
+func (m *metricState) result() *model.MetricValue {
+	result := &model.MetricValue{
+		Fn:    m.metric.Fn,
+		Field: m.metric.Field,
+	}
+	value := func(f float64) {
+		result.Value = &f
+	}
+	switch m.metric.Fn {
+	case model.AggregateFunctionCount:
+		value(float64(m.count))
+	case model.AggregateFunctionCountDistinct:
+		value(float64(len(m.distinct)))
+	case model.AggregateFunctionSum:
+		value(m.sum)
+	case model.AggregateFunctionAvg:
+		if m.numbers > 0 {
+			value(m.sum / float64(m.numbers))
+		}
+	case model.AggregateFunctionMin:
+		if m.numbers > 0 {
+			value(m.min)
+		}
+	case model.AggregateFunctionMax:
+		if m.numbers > 0 {
+			value(m.max)
+		}
+	case model.AggregateFunctionPercentile:
+		if m.numbers > 0 {
+			p := float64(defaultPercentile)
+			if m.metric.Percentile != nil {
+				p = *m.metric.Percentile
+			}
+			value(percentile(m.values, p))
+		}
+	case model.AggregateFunctionHistogram:
+		result.Buckets = []*model.Bucket{}
+		for start, count := range m.buckets {
+			result.Buckets = append(result.Buckets, &model.Bucket{Start: start, Count: count})
+		}
+		sort.Slice(result.Buckets, func(i, j int) bool {
+			return result.Buckets[i].Start < result.Buckets[j].Start
+		})
+	}
+	return result
+}
+
+// percentile returns the p'th percentile of the values, interpolating between the closest ranks
+func percentile(values []float64, p float64) float64 {
+	sort.Float64s(values)
+	rank := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	if lower+1 >= len(values) {
+		return values[len(values)-1]
+	}
+	return values[lower] + (rank-float64(lower))*(values[lower+1]-values[lower])
+}
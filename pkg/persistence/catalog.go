@@ -0,0 +1,298 @@
+package persistence
+
+import (
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/palantir/stacktrace"
+	"github.com/spf13/cast"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// the catalog records every node type, relation type, property & value kind that has been written to the graph.
+// Entries are stored under the catalog prefix followed by the prefix of the data they describe:
+//
+//	6,1,<node type>
+//	6,2,<relation>
+//	6,3,<source type>,<relation>,<target type>
+//	6,4,<node type>,<field>,<value kind>
+//	6,5,<relation>,<field>,<value kind>
+//
+// The catalog only grows - entries are not removed when the data they describe is deleted.
+
+var internalFields = map[string]struct{}{
+	Internal_ID:         {},
+	Internal_Type:       {},
+	Internal_Direction:  {},
+	Internal_Relation:   {},
+	Internal_SourceType: {},
+	Internal_SourceID:   {},
+	Internal_TargetType: {},
+	Internal_TargetID:   {},
+	Internal_Version:    {},
+}
+
+func (d *DB) catalogMap(prefix string) *sync.Map {
+	switch prefix {
+	case nodesPrefix:
+		return &d.nodeTypes
+	case relationPrefix:
+		return &d.relationTypes
+	case nodeRelationPrefix:
+		return &d.nodeRelationMap
+	case nodeFieldsPrefix:
+		return &d.nodeFieldMap
+	case relationFieldsPrefix:
+		return &d.relationFieldMap
+	}
+	return nil
+}
+
+func nodeCatalogEntries(nodeType string, properties map[string]interface{}) [][]string {
+	entries := [][]string{{nodesPrefix, nodeType}}
+	for k, v := range properties {
+		if _, ok := internalFields[k]; ok {
+			continue
+		}
+		entries = append(entries, []string{nodeFieldsPrefix, nodeType, k, string(valueKind(v))})
+	}
+	return entries
+}
+
+func relationCatalogEntries(relation string, properties map[string]interface{}) [][]string {
+	entries := [][]string{{relationPrefix, relation}}
+	if sourceType, targetType := cast.ToString(properties[Internal_SourceType]), cast.ToString(properties[Internal_TargetType]); sourceType != "" && targetType != "" {
+		entries = append(entries, []string{nodeRelationPrefix, sourceType, relation, targetType})
+	}
+	for k, v := range properties {
+		if _, ok := internalFields[k]; ok {
+			continue
+		}
+		entries = append(entries, []string{relationFieldsPrefix, relation, k, string(valueKind(v))})
+	}
+	return entries
+}
+
+// catalog writes any catalog entries that haven't been seen before within the transaction. The returned function
+// must be called once the transaction has been committed to register the entries in memory.
+func (d *DB) catalog(txn *badger.Txn, entries [][]string) (func(), error) {
+	var added [][]string
+	for _, entry := range entries {
+		if _, ok := d.catalogMap(entry[0]).Load(strings.Join(entry[1:], ",")); ok {
+			continue
+		}
+		if err := txn.Set(getCatalogPath(entry...), []byte{}); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		added = append(added, entry)
+	}
+	return func() {
+		for _, entry := range added {
+			d.catalogMap(entry[0]).Store(strings.Join(entry[1:], ","), struct{}{})
+		}
+	}, nil
+}
+
+// loadCatalog rebuilds the in-memory catalog from storage. If storage contains data that was written before the
+// catalog existed, the catalog is backfilled from it.
+func (d *DB) loadCatalog() error {
+	for _, prefix := range []string{nodesPrefix, relationPrefix, nodeRelationPrefix, nodeFieldsPrefix, relationFieldsPrefix} {
+		m := d.catalogMap(prefix)
+		m.Range(func(key, value interface{}) bool {
+			m.Delete(key)
+			return true
+		})
+	}
+	var empty = true
+	if err := d.db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		prefix := getCatalogPath()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			split := strings.Split(string(it.Item().Key()), ",")
+			if len(split) < 3 {
+				continue
+			}
+			if m := d.catalogMap(split[1]); m != nil {
+				m.Store(strings.Join(split[2:], ","), struct{}{})
+				empty = false
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "failed to load catalog")
+	}
+	if !empty {
+		return nil
+	}
+	if err := d.backfillCatalog(); err != nil {
+		return stacktrace.Propagate(err, "failed to backfill catalog")
+	}
+	return nil
+}
+
+func (d *DB) backfillCatalog() error {
+	for _, prefix := range [][]byte{getNodePath("", ""), []byte(relationPrefix)} {
+		var entries [][]string
+		if err := d.db.View(func(txn *badger.Txn) error {
+			opt := badger.DefaultIteratorOptions
+			opt.PrefetchSize = prefetchSize
+			it := txn.NewIterator(opt)
+			defer it.Close()
+			for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+				item := it.Item()
+				split := strings.Split(string(item.Key()), ",")
+				if len(split) < 3 {
+					continue
+				}
+				data := map[string]interface{}{}
+				if err := item.Value(func(val []byte) error {
+					return encode.Unmarshal(val, &data)
+				}); err != nil {
+					return stacktrace.Propagate(err, "key=%s", string(item.Key()))
+				}
+				if string(prefix) == relationPrefix {
+					entries = append(entries, relationCatalogEntries(split[1], data)...)
+				} else {
+					entries = append(entries, nodeCatalogEntries(split[1], data)...)
+				}
+			}
+			return nil
+		}); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		batch := d.db.NewWriteBatch()
+		for _, entry := range entries {
+			key := strings.Join(entry[1:], ",")
+			if _, loaded := d.catalogMap(entry[0]).LoadOrStore(key, struct{}{}); loaded {
+				continue
+			}
+			if err := batch.Set(getCatalogPath(entry...), []byte{}); err != nil {
+				batch.Cancel()
+				return stacktrace.Propagate(err, "")
+			}
+		}
+		if err := batch.Flush(); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	return nil
+}
+
+func (d *DB) Schema() []*model.NodeSchema {
+	var (
+		nodeFields     = map[string]map[string][]model.ValueKind{}
+		relationFields = map[string]map[string][]model.ValueKind{}
+		relations      = map[string][]*model.RelationSchema{}
+	)
+	for _, fields := range []struct {
+		m      *sync.Map
+		fields map[string]map[string][]model.ValueKind
+	}{
+		{m: &d.nodeFieldMap, fields: nodeFields},
+		{m: &d.relationFieldMap, fields: relationFields},
+	} {
+		fields.m.Range(func(key, value interface{}) bool {
+			split := strings.Split(key.(string), ",")
+			if len(split) != 3 {
+				return true
+			}
+			if fields.fields[split[0]] == nil {
+				fields.fields[split[0]] = map[string][]model.ValueKind{}
+			}
+			fields.fields[split[0]][split[1]] = append(fields.fields[split[0]][split[1]], model.ValueKind(split[2]))
+			return true
+		})
+	}
+	d.nodeRelationMap.Range(func(key, value interface{}) bool {
+		split := strings.Split(key.(string), ",")
+		if len(split) != 3 {
+			return true
+		}
+		sourceType, relation, targetType := split[0], split[1], split[2]
+		relations[sourceType] = append(relations[sourceType], &model.RelationSchema{
+			Relation:   relation,
+			Direction:  model.DirectionOutgoing,
+			TargetType: targetType,
+			Properties: toPropertySchemas(relationFields[relation]),
+		})
+		relations[targetType] = append(relations[targetType], &model.RelationSchema{
+			Relation:   relation,
+			Direction:  model.DirectionIncoming,
+			TargetType: sourceType,
+			Properties: toPropertySchemas(relationFields[relation]),
+		})
+		return true
+	})
+	var schemas []*model.NodeSchema
+	for _, nodeType := range d.NodeTypes() {
+		rels := relations[nodeType]
+		sort.Slice(rels, func(i, j int) bool {
+			if rels[i].Relation != rels[j].Relation {
+				return rels[i].Relation < rels[j].Relation
+			}
+			if rels[i].Direction != rels[j].Direction {
+				return rels[i].Direction > rels[j].Direction
+			}
+			return rels[i].TargetType < rels[j].TargetType
+		})
+		schemas = append(schemas, &model.NodeSchema{
+			Type:       nodeType,
+			Properties: toPropertySchemas(nodeFields[nodeType]),
+			Relations:  rels,
+		})
+	}
+	return schemas
+}
+
+func toPropertySchemas(fields map[string][]model.ValueKind) []*model.PropertySchema {
+	var properties []*model.PropertySchema
+	for name, kinds := range fields {
+		sort.Slice(kinds, func(i, j int) bool {
+			return kinds[i] < kinds[j]
+		})
+		properties = append(properties, &model.PropertySchema{
+			Name:  name,
+			Kinds: kinds,
+		})
+	}
+	sort.Slice(properties, func(i, j int) bool {
+		return properties[i].Name < properties[j].Name
+	})
+	return properties
+}
+
+// valueKind infers the kind of a property value as it was provided by a client or decoded from storage
+func valueKind(value interface{}) model.ValueKind {
+	switch value.(type) {
+	case nil:
+		return model.ValueKindNull
+	case string, api.Direction:
+		return model.ValueKindString
+	case bool:
+		return model.ValueKindBool
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return model.ValueKindInt
+	case float32, float64:
+		return model.ValueKindFloat
+	case time.Time, primitive.DateTime, primitive.Timestamp:
+		return model.ValueKindTime
+	case primitive.D, primitive.M:
+		return model.ValueKindMap
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Map, reflect.Struct:
+		return model.ValueKindMap
+	case reflect.Slice, reflect.Array:
+		return model.ValueKindArray
+	}
+	return model.ValueKindString
+}
@@ -0,0 +1,235 @@
+package persistence
+
+import (
+	"encoding/binary"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/palantir/stacktrace"
+	"strings"
+	"sync"
+)
+
+// the number of nodes of each type & relations of each type are stored under the meta prefix & updated in the same
+// transaction as the writes that add or delete them, so counting every node or relation of a type is a single read:
+//
+//	0,count,1,<node type>
+//	0,count,2,<relation>
+//
+// countsVersionKey is set once the counters have been backfilled from the data written before they existed.
+const countsVersionKey = "counts_version"
+
+func readCount(txn *badger.Txn, key []byte) (int, error) {
+	item, err := txn.Get(key)
+	if err == badger.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	var count int
+	if err := item.Value(func(val []byte) error {
+		if len(val) != 8 {
+			return stacktrace.NewError("bad counter: %s", string(key))
+		}
+		count = int(int64(binary.BigEndian.Uint64(val)))
+		return nil
+	}); err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	return count, nil
+}
+
+func writeCount(set func(key, val []byte) error, key []byte, count int) error {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(int64(count)))
+	return set(key, buf)
+}
+
+// addCount adds delta to the counter within the transaction
+func addCount(txn *badger.Txn, key []byte, delta int) error {
+	count, err := readCount(txn, key)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return writeCount(txn.Set, key, count+delta)
+}
+
+// migrateCounts backfills the node & relation counters if they haven't been written yet
+func (d *DB) migrateCounts() error {
+	versionKey := getMetaPath(countsVersionKey)
+	var counts = map[string]int{}
+	if err := d.db.View(func(txn *badger.Txn) error {
+		if _, err := txn.Get(versionKey); err != badger.ErrKeyNotFound {
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+			counts = nil
+			return nil
+		}
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		for _, prefix := range []string{nodesPrefix, relationPrefix} {
+			for it.Seek([]byte(prefix + ",")); it.ValidForPrefix([]byte(prefix + ",")); it.Next() {
+				split := strings.Split(string(it.Item().Key()), ",")
+				if len(split) < 3 {
+					continue
+				}
+				counts[string(getCountPath(prefix, split[1]))]++
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if counts == nil {
+		return nil
+	}
+	batch := d.db.NewWriteBatch()
+	for key, count := range counts {
+		if err := writeCount(batch.Set, []byte(key), count); err != nil {
+			batch.Cancel()
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	if err := batch.Set(versionKey, []byte("1")); err != nil {
+		batch.Cancel()
+		return stacktrace.Propagate(err, "")
+	}
+	return batch.Flush()
+}
+
+// unfiltered returns whether the expressions & filter match every entity
+func unfiltered(expressions []*model.Expression, filter *model.Filter) bool {
+	return len(expressions) == 0 && (filter == nil || (len(filter.Expressions) == 0 && len(filter.And) == 0 && len(filter.Or) == 0 && filter.Not == nil))
+}
+
+// soleExpression returns the expression if the expressions & filter consist of exactly one expression
+func soleExpression(expressions []*model.Expression, filter *model.Filter) *model.Expression {
+	if filter != nil {
+		if len(filter.And) > 0 || len(filter.Or) > 0 || filter.Not != nil {
+			return nil
+		}
+		expressions = append(append([]*model.Expression{}, expressions...), filter.Expressions...)
+	}
+	if len(expressions) != 1 {
+		return nil
+	}
+	return expressions[0]
+}
+
+// exactRange returns whether the ranges contain a single key for each entity that matches the expressions & filter
+// and no others, so the entities can be counted without reading them. That's the case if the only expression is
+// answered by the index of a top level field that has never held an array(which would have a key per element).
+func exactRange(kr keyRanges, fields *sync.Map, typee string, expressions []*model.Expression, filter *model.Filter) bool {
+	exp := soleExpression(expressions, filter)
+	if exp == nil || len(kr) == 0 || kr[0].fieldPrefix == nil || kr[0].field != exp.Key || strings.Contains(exp.Key, ".") {
+		return false
+	}
+	if _, ok := fields.Load(strings.Join([]string{typee, exp.Key, string(model.ValueKindArray)}, ",")); ok {
+		return false
+	}
+	switch exp.Operator {
+	case model.OperatorEq, model.OperatorGt, model.OperatorGte, model.OperatorLt, model.OperatorLte:
+		// entities without the field match a null value but don't have an index key
+		return exp.Value != nil
+	case model.OperatorIn, model.OperatorContainsAny:
+		values, err := listValue(exp)
+		if err != nil {
+			return false
+		}
+		for _, value := range values {
+			if value == nil {
+				return false
+			}
+		}
+		return true
+	case model.OperatorBetween, model.OperatorHasPrefix:
+		return true
+	}
+	return false
+}
+
+// countRange counts the entities in the ranges that match the expressions & filter. Values are only read if the
+// ranges aren't exact.
+func countRange(txn *badger.Txn, kr keyRanges, exact bool, expressions []*model.Expression, filter *model.Filter, load func(item *badger.Item) (api.Entity, error)) (int, error) {
+	regexes, err := compileRegexes(expressions, filter)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	for i := range kr {
+		kr[i].keysOnly = true
+	}
+	var count int
+	_, err = kr.iterate(txn, nil, func(i int, item *badger.Item) (bool, error) {
+		if exact {
+			count++
+			return true, nil
+		}
+		ent, err := load(item)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+		passed, err := matches(expressions, filter, ent, regexes)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+		if passed {
+			duplicate, err := kr.duplicate(i, item.Key(), ent)
+			if err != nil {
+				return false, stacktrace.Propagate(err, "")
+			}
+			passed = !duplicate
+		}
+		if passed {
+			count++
+		}
+		return true, nil
+	})
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	return count, nil
+}
+
+func (d *DB) CountNodes(where *model.NodeWhere) (int, error) {
+	var count int
+	if err := d.db.View(func(txn *badger.Txn) error {
+		var err error
+		if unfiltered(where.Expressions, where.Filter) {
+			count, err = readCount(txn, getCountPath(nodesPrefix, where.Type))
+			return err
+		}
+		kr := nodeRange(&model.NodeWhere{Type: where.Type, Expressions: where.Expressions, Filter: where.Filter})
+		exact := exactRange(kr, &d.nodeFieldMap, where.Type, where.Expressions, where.Filter)
+		count, err = countRange(txn, kr, exact, where.Expressions, where.Filter, func(item *badger.Item) (api.Entity, error) {
+			return d.itemNode(where.Type, item)
+		})
+		return err
+	}); err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	return count, nil
+}
+
+func (d *DB) CountRelations(where *model.RelationWhere) (int, error) {
+	var count int
+	if err := d.db.View(func(txn *badger.Txn) error {
+		var err error
+		if unfiltered(where.Expressions, where.Filter) {
+			count, err = readCount(txn, getCountPath(relationPrefix, where.Relation))
+			return err
+		}
+		kr := relationRange(&model.RelationWhere{Relation: where.Relation, Expressions: where.Expressions, Filter: where.Filter})
+		exact := exactRange(kr, &d.relationFieldMap, where.Relation, where.Expressions, where.Filter)
+		count, err = countRange(txn, kr, exact, where.Expressions, where.Filter, func(item *badger.Item) (api.Entity, error) {
+			return d.itemRelation(where.Relation, item)
+		})
+		return err
+	}); err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	return count, nil
+}
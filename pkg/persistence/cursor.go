@@ -0,0 +1,95 @@
+package persistence
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/palantir/stacktrace"
+	"strconv"
+	"strings"
+)
+
+// cursor is the position of a listing after a page. Seek cursors hold the last key that was iterated over so that the
+// next page starts right after it & sort cursors hold the last entity of a bounded sort. Offset cursors, which count
+// the keys(or sorted entities) to skip, are deprecated but still accepted from older clients.
+type cursor struct {
+	kind   string
+	offset int
+	// rangeIndex & key are the range & last key of a seek cursor
+	rangeIndex int
+	key        []byte
+	// item is the last entity of a sort cursor
+	item *sortItem
+}
+
+const (
+	offsetCursor = "cursor"
+	seekCursor   = "seek"
+	sortCursor   = "sort"
+)
+
+// parseCursor parses a cursor returned by a listing. A nil cursor starts from the beginning.
+func parseCursor(c *string) (*cursor, error) {
+	if c == nil || *c == "" {
+		return nil, nil
+	}
+	bits, err := base64.StdEncoding.DecodeString(*c)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "bad cursor")
+	}
+	split := strings.SplitN(string(bits), "-", 3)
+	switch {
+	case split[0] == offsetCursor && len(split) == 2:
+		offset, err := strconv.Atoi(split[1])
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "bad cursor")
+		}
+		return &cursor{kind: offsetCursor, offset: offset}, nil
+	case split[0] == seekCursor && len(split) == 3:
+		rangeIndex, err := strconv.Atoi(split[1])
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "bad cursor")
+		}
+		key, err := hex.DecodeString(split[2])
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "bad cursor")
+		}
+		return &cursor{kind: seekCursor, rangeIndex: rangeIndex, key: key}, nil
+	case split[0] == sortCursor && len(split) == 3:
+		key, err := hex.DecodeString(split[1])
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "bad cursor")
+		}
+		// encoded values are never empty, so an empty key is an entity without a value
+		if len(key) == 0 {
+			key = nil
+		}
+		return &cursor{kind: sortCursor, item: &sortItem{key: key, id: split[2]}}, nil
+	}
+	return nil, stacktrace.NewError("bad cursor")
+}
+
+func (c *cursor) String() string {
+	var str string
+	switch c.kind {
+	case seekCursor:
+		str = fmt.Sprintf("%s-%v-%s", seekCursor, c.rangeIndex, hex.EncodeToString(c.key))
+	case sortCursor:
+		str = fmt.Sprintf("%s-%s-%s", sortCursor, hex.EncodeToString(c.item.key), c.item.id)
+	default:
+		str = fmt.Sprintf("%s-%v", offsetCursor, c.offset)
+	}
+	return base64.StdEncoding.EncodeToString([]byte(str))
+}
+
+// nextCursor returns the cursor of the page after the one that ended at the given cursor. The cursor of the request is
+// returned if the page was empty so that the listing stays at its end.
+func nextCursor(next *cursor, previous *string) string {
+	if next != nil {
+		return next.String()
+	}
+	if previous != nil {
+		return *previous
+	}
+	return ""
+}
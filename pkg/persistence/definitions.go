@@ -0,0 +1,320 @@
+package persistence
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/autom8ter/morpheus/pkg/constants"
+	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/autom8ter/morpheus/pkg/logger"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/palantir/stacktrace"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// definitions are the optional schemas that writes to nodes & relations of a type are validated against. Types without
+// a definition accept any properties. Definitions are stored under the definitions prefix:
+//
+//	7,1,<node type>
+//	7,2,<relation>
+
+// definition is a type definition along with its parsed constraints
+type definition struct {
+	*model.TypeDefinition
+	properties map[string]*model.PropertyDefinition
+	regexes    map[string]*regexp.Regexp
+	// unique are the unique constraints of the definition with their properties sorted
+	unique [][]string
+}
+
+func newDefinition(prefix string, def *model.TypeDefinition) (*definition, error) {
+	if def.Type == "" {
+		return nil, stacktrace.NewError("empty definition type")
+	}
+	if !def.Mode.IsValid() {
+		return nil, stacktrace.NewError("unsupported schema mode: %s", def.Mode)
+	}
+	if prefix != relationPrefix && len(def.Endpoints) > 0 {
+		return nil, stacktrace.NewError("endpoints are only supported by relation definitions")
+	}
+	unique, err := parseUnique(prefix, def.Unique)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	parsed := &definition{
+		TypeDefinition: def,
+		properties:     map[string]*model.PropertyDefinition{},
+		regexes:        map[string]*regexp.Regexp{},
+		unique:         unique,
+	}
+	for _, prop := range def.Properties {
+		if _, ok := internalFields[prop.Name]; ok || prop.Name == "" {
+			return nil, stacktrace.NewError("invalid property name: %q", prop.Name)
+		}
+		if _, ok := parsed.properties[prop.Name]; ok {
+			return nil, stacktrace.NewError("duplicate property: %s", prop.Name)
+		}
+		if prop.Kind != nil && !prop.Kind.IsValid() {
+			return nil, stacktrace.NewError("unsupported kind of %s: %s", prop.Name, *prop.Kind)
+		}
+		if prop.Regex != nil {
+			re, err := regexp.Compile(*prop.Regex)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "invalid regex of %s", prop.Name)
+			}
+			parsed.regexes[prop.Name] = re
+		}
+		parsed.properties[prop.Name] = prop
+	}
+	for _, prop := range def.Properties {
+		if prop.Default == nil {
+			continue
+		}
+		if violation := parsed.check(prop, prop.Default); violation != "" {
+			return nil, stacktrace.NewError("invalid default of %s: %s", prop.Name, violation)
+		}
+	}
+	return parsed, nil
+}
+
+// check returns why the value violates the property definition or an empty string if it doesn't
+func (d *definition) check(prop *model.PropertyDefinition, value interface{}) string {
+	if prop.Kind != nil && !kindMatches(*prop.Kind, value) {
+		return fmt.Sprintf("expected %s, got %s", *prop.Kind, valueKind(value))
+	}
+	if len(prop.Enum) > 0 {
+		var found bool
+		for _, allowed := range prop.Enum {
+			if bytes.Equal(encodeIndexValue(allowed), encodeIndexValue(value)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Sprintf("%v is not one of %v", value, prop.Enum)
+		}
+	}
+	if re, ok := d.regexes[prop.Name]; ok {
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Sprintf("expected a string matching %s", re.String())
+		}
+		if !re.MatchString(s) {
+			return fmt.Sprintf("%q does not match %s", s, re.String())
+		}
+	}
+	return ""
+}
+
+// validate applies the defaults of the definition to the properties & returns the violations of the definition
+func (d *definition) validate(properties map[string]interface{}) map[string]string {
+	violations := map[string]string{}
+	for name, prop := range d.properties {
+		value := properties[name]
+		if value == nil && prop.Default != nil {
+			value = prop.Default
+			properties[name] = value
+		}
+		if value == nil {
+			if prop.Required {
+				violations[name] = "required"
+			}
+			continue
+		}
+		if violation := d.check(prop, value); violation != "" {
+			violations[name] = violation
+		}
+	}
+	if !d.AdditionalProperties {
+		for name := range properties {
+			if _, ok := internalFields[name]; ok {
+				continue
+			}
+			if _, ok := d.properties[name]; !ok {
+				violations[name] = "not defined"
+			}
+		}
+	}
+	return violations
+}
+
+// kindMatches returns whether the value is of the kind. Integers are valid floats.
+func kindMatches(kind model.ValueKind, value interface{}) bool {
+	actual := valueKind(value)
+	if _, ok := toFloat(value); ok {
+		actual = model.ValueKindFloat
+		if isInt(value) {
+			actual = model.ValueKindInt
+		}
+	}
+	return actual == kind || (kind == model.ValueKindFloat && actual == model.ValueKindInt)
+}
+
+func (d *DB) definitionMap(prefix string) *sync.Map {
+	switch prefix {
+	case nodesPrefix:
+		return &d.nodeDefinitions
+	case relationPrefix:
+		return &d.relationDefinitions
+	}
+	return nil
+}
+
+// validate validates the properties of a node or relation against the definition of its type, applying the defaults
+// of the definition to them. endpoint is the source & target type of a relation. Violations of definitions in warn
+// mode are logged rather than returned.
+func (d *DB) validate(prefix, typee string, properties map[string]interface{}, endpoint *model.Endpoint) error {
+	val, ok := d.definitionMap(prefix).Load(typee)
+	if !ok {
+		return nil
+	}
+	def := val.(*definition)
+	violations := def.validate(properties)
+	if endpoint != nil && len(def.Endpoints) > 0 {
+		var allowed bool
+		for _, e := range def.Endpoints {
+			if e.Source == endpoint.Source && e.Target == endpoint.Target {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			violations[Internal_Relation] = fmt.Sprintf("%s -> %s is not an allowed endpoint", endpoint.Source, endpoint.Target)
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	if def.Mode == model.SchemaModeWarn {
+		logger.L.Warn("schema violation", map[string]interface{}{
+			"type":       typee,
+			"violations": violations,
+		})
+		return nil
+	}
+	return stacktrace.PropagateWithCode(&constants.ValidationError{Type: typee, Violations: violations}, http.StatusBadRequest, "")
+}
+
+// define registers the definition of a node type or relation within the transaction, replacing its existing definition
+func (d *DB) define(t *tx, prefix string, def *model.TypeDefinition) error {
+	parsed, err := newDefinition(prefix, def)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if prefix == nodesPrefix {
+		if err := d.reindexUnique(t, def.Type, d.uniqueConstraints(def.Type), parsed.unique); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	bits, err := encode.Marshal(def)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if err := t.txn.Set(getDefinitionPath(prefix, def.Type), bits); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	t.afterCommit(func() {
+		d.definitionMap(prefix).Store(def.Type, parsed)
+	})
+	return nil
+}
+
+func (d *DB) dropDefinition(t *tx, prefix, typee string) error {
+	if prefix == nodesPrefix {
+		if err := d.reindexUnique(t, typee, d.uniqueConstraints(typee), nil); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	if err := t.txn.Delete(getDefinitionPath(prefix, typee)); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	t.afterCommit(func() {
+		d.definitionMap(prefix).Delete(typee)
+	})
+	return nil
+}
+
+func (d *DB) DefineNode(def *model.TypeDefinition) error {
+	return d.update(func(t *tx) error {
+		return d.define(t, nodesPrefix, def)
+	})
+}
+
+func (d *DB) DefineRelation(def *model.TypeDefinition) error {
+	return d.update(func(t *tx) error {
+		return d.define(t, relationPrefix, def)
+	})
+}
+
+func (d *DB) DropNodeDefinition(nodeType string) error {
+	return d.update(func(t *tx) error {
+		return d.dropDefinition(t, nodesPrefix, nodeType)
+	})
+}
+
+func (d *DB) DropRelationDefinition(relation string) error {
+	return d.update(func(t *tx) error {
+		return d.dropDefinition(t, relationPrefix, relation)
+	})
+}
+
+func (d *DB) NodeDefinitions() []*model.TypeDefinition {
+	return d.definitions(nodesPrefix)
+}
+
+func (d *DB) RelationDefinitions() []*model.TypeDefinition {
+	return d.definitions(relationPrefix)
+}
+
+func (d *DB) definitions(prefix string) []*model.TypeDefinition {
+	var defs []*model.TypeDefinition
+	d.definitionMap(prefix).Range(func(key, value interface{}) bool {
+		defs = append(defs, value.(*definition).TypeDefinition)
+		return true
+	})
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Type < defs[j].Type
+	})
+	return defs
+}
+
+// loadDefinitions rebuilds the in-memory definitions from storage
+func (d *DB) loadDefinitions() error {
+	for _, prefix := range []string{nodesPrefix, relationPrefix} {
+		m := d.definitionMap(prefix)
+		m.Range(func(key, value interface{}) bool {
+			m.Delete(key)
+			return true
+		})
+	}
+	if err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte(definitionPrefix + ",")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			split := strings.SplitN(string(it.Item().Key()), ",", 3)
+			if len(split) < 3 || d.definitionMap(split[1]) == nil {
+				continue
+			}
+			def := &model.TypeDefinition{}
+			if err := it.Item().Value(func(val []byte) error {
+				return encode.Unmarshal(val, def)
+			}); err != nil {
+				return stacktrace.Propagate(err, "key=%s", string(it.Item().Key()))
+			}
+			parsed, err := newDefinition(split[1], def)
+			if err != nil {
+				return stacktrace.Propagate(err, "key=%s", string(it.Item().Key()))
+			}
+			d.definitionMap(split[1]).Store(def.Type, parsed)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "failed to load definitions")
+	}
+	return nil
+}
@@ -1,10 +1,13 @@
 package persistence
 
 import (
-	"github.com/autom8ter/morpheus/pkg/api"
+	"encoding/binary"
 	"github.com/autom8ter/morpheus/pkg/encode"
 	"github.com/autom8ter/morpheus/pkg/graph/fsm"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
 	"github.com/autom8ter/morpheus/pkg/helpers"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/badger/v3/pb"
 	"github.com/hashicorp/raft"
 	"github.com/palantir/stacktrace"
 	"io"
@@ -17,137 +20,281 @@ func (d *DB) FSM() raft.FSM {
 			if err := encode.Unmarshal(log.Data, &cmd); err != nil {
 				return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
 			}
-			switch cmd.Method {
-			case fsm.MethodAdd:
-				addNode := cmd.Node
-				n, err := d.AddNode(addNode.Type, addNode.ID, addNode.Properties)
-				if err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-				}
-				return n
-			case fsm.MethodSet:
-				addNode := cmd.Node
-				n, err := d.AddNode(addNode.Type, addNode.ID, addNode.Properties)
-				if err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-				}
-				return n
-			case fsm.MethodDel:
-				key := cmd.Key
-				err := d.DelNode(key.Type, key.ID)
-				if err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-				}
-				return true
-			case fsm.MethodBulkDel:
-				keys := cmd.Keys
-				for _, key := range keys {
-					err := d.DelNode(key.Type, key.ID)
-					if err != nil {
-						return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-					}
-				}
-				return true
-			case fsm.MethodBulkSet:
-				sets := cmd.SetNodes
-				for _, set := range sets {
-					_, err := d.AddNode(set.Type, set.ID, set.Properties)
-					if err != nil {
-						return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-					}
-				}
-				return true
-			case fsm.MethodBulkAdd:
-				adds := cmd.AddNodes
-				for _, add := range adds {
-					_, err := d.AddNode(add.Type, *add.ID, add.Properties)
-					if err != nil {
-						return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-					}
-				}
-				return true
-			case fsm.MethodNodeSetProperties:
-				var (
-					sourceType = cmd.Metadata["type"]
-					sourceID   = cmd.Metadata["id"]
-				)
-				if sourceType == "" || sourceID == "" {
-					return stacktrace.NewError("bad raft cmd")
-				}
-				props := cmd.Properties
-				n, err := d.GetNode(sourceType, sourceID)
-				if err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-				}
-				if err := n.SetProperties(props); err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-				}
-				return n
-			case fsm.MethodNodeAddRelation:
-				key := cmd.Key
-				var (
-					sourceType = cmd.Metadata["source.type"]
-					sourceID   = cmd.Metadata["source.id"]
-					relation   = cmd.Metadata["relation"]
-					direction  = cmd.Metadata["direction"]
-				)
-				if sourceType == "" || sourceID == "" || relation == "" || direction == "" {
-					return stacktrace.NewError("bad raft cmd")
-				}
-				source, err := d.GetNode(sourceType, sourceID)
-				if err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-				}
-				target, err := d.GetNode(key.Type, key.ID)
-				if err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-				}
-				rel, err := source.AddRelation(api.Direction(direction), relation, cmd.Properties, target)
-				if err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-				}
-				return rel
-			case fsm.MethodNodeDelRelation:
-				var (
-					sourceType = cmd.Metadata["type"]
-					sourceID   = cmd.Metadata["id"]
-				)
-				key := cmd.Key
-				source, err := d.GetNode(sourceType, sourceID)
-				if err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-				}
-				err = source.DelRelation(key.Type, key.ID)
-				if err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
-				}
-				return true
-			case fsm.MethodRelationSetProperties:
-				var (
-					sourceType = cmd.Metadata["type"]
-					sourceID   = cmd.Metadata["id"]
-				)
-				if sourceType == "" || sourceID == "" {
-					return stacktrace.NewError("bad raft cmd")
-				}
-				props := cmd.Properties
-				rel, err := d.GetRelation(sourceType, sourceID)
+			result, err := d.apply(log.Index, cmd)
+			if err != nil {
+				return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
+			}
+			return result
+		},
+		SnapshotFunc: d.snapshot,
+		RestoreFunc:  d.restore,
+	}
+}
+
+// appliedIndexKey is the meta key of the raft log index of the last command that was applied. It's written in the
+// same transaction as the command.
+const appliedIndexKey = "applied_index"
+
+func getAppliedIndex(txn *badger.Txn) (uint64, error) {
+	item, err := txn.Get(getMetaPath(appliedIndexKey))
+	if err == badger.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	var index uint64
+	if err := item.Value(func(val []byte) error {
+		if len(val) != 8 {
+			return stacktrace.NewError("bad applied index")
+		}
+		index = binary.BigEndian.Uint64(val)
+		return nil
+	}); err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	return index, nil
+}
+
+func setAppliedIndex(txn *badger.Txn, index uint64) error {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, index)
+	return txn.Set(getMetaPath(appliedIndexKey), buf)
+}
+
+// apply applies the command in a single transaction at the raft log index, which becomes the version of every
+// node & relation it writes. Raft replays the log entries after the last snapshot onto the persisted store when it
+// restarts, so commands at or below the last applied index are skipped - otherwise versions, increments & appends
+// would be applied twice on the restarted member only.
+func (d *DB) apply(index uint64, cmd fsm.CMD) (interface{}, error) {
+	var applied uint64
+	if err := d.db.View(func(txn *badger.Txn) error {
+		var err error
+		applied, err = getAppliedIndex(txn)
+		return err
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if index <= applied {
+		return nil, nil
+	}
+	var ops []*model.Op
+	switch cmd.Method {
+	case fsm.MethodAdd, fsm.MethodSet:
+		ops = append(ops, &model.Op{SetNode: &model.SetNode{
+			Type:       cmd.Node.Type,
+			ID:         cmd.Node.ID,
+			Properties: cmd.Node.Properties,
+			IfVersion:  cmd.IfVersion(),
+		}})
+	case fsm.MethodDel:
+		detach := cmd.Detach()
+		ops = append(ops, &model.Op{DelNode: &model.DelNode{Key: &cmd.Key, Detach: &detach, IfVersion: cmd.IfVersion()}})
+	case fsm.MethodBulkDel:
+		for _, key := range cmd.Keys {
+			detach := cmd.Detach()
+			ops = append(ops, &model.Op{DelNode: &model.DelNode{Key: key, Detach: &detach}})
+		}
+	case fsm.MethodBulkSet:
+		for _, set := range cmd.SetNodes {
+			ops = append(ops, &model.Op{SetNode: set})
+		}
+	case fsm.MethodBulkAdd:
+		for _, add := range cmd.AddNodes {
+			ops = append(ops, &model.Op{AddNode: add})
+		}
+	case fsm.MethodBulkMerge:
+		if err := d.updateAt(index, func(t *tx) error {
+			for _, set := range cmd.SetNodes {
+				existing, err := getData(t.txn, getNodePath(set.Type, set.ID))
 				if err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
+					return stacktrace.Propagate(err, "")
 				}
-				if err := rel.SetProperties(props); err != nil {
-					return stacktrace.Propagate(err, "command = %s", helpers.JSONString(cmd))
+				if _, err := d.addNode(t, set.Type, set.ID, mergePatch(existing, set.Properties)); err != nil {
+					return stacktrace.Propagate(err, "")
 				}
-				return rel
-			default:
-				return stacktrace.NewError("unknown method: %s", cmd.Method)
 			}
+			return nil
+		}); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		return true, nil
+	case fsm.MethodTransaction:
+		ops = cmd.Ops
+	case fsm.MethodUpsert:
+		ops = append(ops, &model.Op{Upsert: cmd.Upsert})
+	case fsm.MethodDefineNode, fsm.MethodDefineRelation:
+		if cmd.Definition == nil {
+			return nil, stacktrace.NewError("bad raft cmd")
+		}
+		prefix := nodesPrefix
+		if cmd.Method == fsm.MethodDefineRelation {
+			prefix = relationPrefix
+		}
+		if err := d.updateAt(index, func(t *tx) error {
+			return d.define(t, prefix, cmd.Definition)
+		}); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		return true, nil
+	case fsm.MethodDropNodeDefinition, fsm.MethodDropRelationDefinition:
+		prefix := nodesPrefix
+		if cmd.Method == fsm.MethodDropRelationDefinition {
+			prefix = relationPrefix
+		}
+		if err := d.updateAt(index, func(t *tx) error {
+			return d.dropDefinition(t, prefix, cmd.Metadata["type"])
+		}); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		return true, nil
+	case fsm.MethodNodeSetProperties, fsm.MethodRelationSetProperties:
+		key := &model.Key{Type: cmd.Metadata["type"], ID: cmd.Metadata["id"]}
+		if key.Type == "" || key.ID == "" {
+			return nil, stacktrace.NewError("bad raft cmd")
+		}
+		set := &model.SetProperties{Properties: cmd.Properties, IfVersion: cmd.IfVersion()}
+		if cmd.Method == fsm.MethodNodeSetProperties {
+			set.Node = key
+		} else {
+			set.Relation = key
+		}
+		ops = append(ops, &model.Op{SetProperties: set})
+	case fsm.MethodNodePatchProperties, fsm.MethodRelationPatchProperties:
+		key := &model.Key{Type: cmd.Metadata["type"], ID: cmd.Metadata["id"]}
+		if key.Type == "" || key.ID == "" || cmd.Patch == nil {
+			return nil, stacktrace.NewError("bad raft cmd")
+		}
+		patch := &model.PatchProperties{Patch: cmd.Patch, IfVersion: cmd.IfVersion()}
+		if cmd.Method == fsm.MethodNodePatchProperties {
+			patch.Node = key
+		} else {
+			patch.Relation = key
+		}
+		ops = append(ops, &model.Op{PatchProperties: patch})
+	case fsm.MethodNodeAddRelation:
+		var (
+			sourceType = cmd.Metadata["source.type"]
+			sourceID   = cmd.Metadata["source.id"]
+			relation   = cmd.Metadata["relation"]
+			direction  = model.Direction(cmd.Metadata["direction"])
+		)
+		if sourceType == "" || sourceID == "" || relation == "" || direction == "" {
+			return nil, stacktrace.NewError("bad raft cmd")
+		}
+		ops = append(ops, &model.Op{AddRelation: &model.AddRelation{
+			Source:     &model.Key{Type: sourceType, ID: sourceID},
+			Direction:  &direction,
+			Relation:   relation,
+			Properties: cmd.Properties,
+			Target:     &cmd.Key,
+		}})
+	case fsm.MethodNodeDelRelation:
+		ops = append(ops, &model.Op{DelRelation: &cmd.Key})
+	default:
+		return nil, stacktrace.NewError("unknown method: %s", cmd.Method)
+	}
+	results, err := d.transaction(index, ops)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	switch cmd.Method {
+	case fsm.MethodTransaction:
+		return results, nil
+	case fsm.MethodAdd, fsm.MethodSet, fsm.MethodUpsert, fsm.MethodNodeSetProperties, fsm.MethodRelationSetProperties, fsm.MethodNodePatchProperties,
+		fsm.MethodRelationPatchProperties, fsm.MethodNodeAddRelation:
+		return results[0], nil
+	}
+	return true, nil
+}
+
+// snapshot pins a read transaction at the current commit timestamp so the snapshot reflects exactly the
+// commands applied so far, even though raft persists it concurrently with later calls to Apply
+func (d *DB) snapshot() (*fsm.Snapshot, error) {
+	txn := d.db.NewTransaction(false)
+	return &fsm.Snapshot{
+		PersistFunc: func(sink raft.SnapshotSink) error {
+			if err := d.backup(txn, sink); err != nil {
+				sink.Cancel()
+				return stacktrace.Propagate(err, "failed to persist snapshot")
+			}
+			return sink.Close()
 		},
-		SnapshotFunc: func() (*fsm.Snapshot, error) {
-			return nil, stacktrace.NewError("unimplemented")
-		},
-		RestoreFunc: func(closer io.ReadCloser) error {
-			return stacktrace.NewError("unimplemented")
-		},
+		ReleaseFunc: txn.Discard,
+	}, nil
+}
+
+// backup writes every live key in the transaction to w using badger's backup format(length prefixed KVLists)
+// so that it may be loaded with badger.DB.Load
+func (d *DB) backup(txn *badger.Txn, w io.Writer) error {
+	opt := badger.DefaultIteratorOptions
+	opt.PrefetchSize = snapshotBatchSize
+	it := txn.NewIterator(opt)
+	defer it.Close()
+	list := &pb.KVList{}
+	for it.Rewind(); it.Valid(); it.Next() {
+		item := it.Item()
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return stacktrace.Propagate(err, "key=%s", string(item.Key()))
+		}
+		list.Kv = append(list.Kv, &pb.KV{
+			Key:       item.KeyCopy(nil),
+			Value:     val,
+			UserMeta:  []byte{item.UserMeta()},
+			Version:   item.Version(),
+			ExpiresAt: item.ExpiresAt(),
+		})
+		if len(list.Kv) >= snapshotBatchSize {
+			if err := writeKVList(w, list); err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+			list = &pb.KVList{}
+		}
+	}
+	if len(list.Kv) > 0 {
+		if err := writeKVList(w, list); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	return nil
+}
+
+// restore replaces the entire contents of the database with the snapshot
+func (d *DB) restore(closer io.ReadCloser) error {
+	defer closer.Close()
+	if err := d.db.DropAll(); err != nil {
+		return stacktrace.Propagate(err, "failed to drop existing data")
+	}
+	d.cache.Clear()
+	if err := d.db.Load(closer, snapshotMaxPendingWrites); err != nil {
+		return stacktrace.Propagate(err, "failed to load snapshot")
+	}
+	if err := d.loadDefinitions(); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if err := d.migrateIndexes(); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if err := d.migrateCounts(); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if err := d.loadCatalog(); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return nil
+}
+
+func writeKVList(w io.Writer, list *pb.KVList) error {
+	bits, err := list.Marshal()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint64(len(bits))); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if _, err := w.Write(bits); err != nil {
+		return stacktrace.Propagate(err, "")
 	}
+	return nil
 }
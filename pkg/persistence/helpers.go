@@ -1,27 +1,35 @@
 package persistence
 
 import (
+	"bytes"
 	"crypto/sha1"
-	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"github.com/autom8ter/morpheus/pkg/api"
 	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/palantir/stacktrace"
 	"github.com/spf13/cast"
-	"strconv"
+	"reflect"
+	"regexp"
 	"strings"
 )
 
 var (
+	metaPrefix           = "0"
 	nodesPrefix          = "1"
 	relationPrefix       = "2"
 	nodeRelationPrefix   = "3"
 	nodeFieldsPrefix     = "4"
 	relationFieldsPrefix = "5"
+	catalogPrefix        = "6"
+	definitionPrefix     = "7"
+	uniquePrefix         = "8"
 )
 
 const (
-	prefetchSize = 25
+	prefetchSize             = 25
+	snapshotBatchSize        = 1000
+	snapshotMaxPendingWrites = 256
 )
 
 const (
@@ -33,10 +41,12 @@ const (
 	Internal_SourceID   = "_source_id"
 	Internal_TargetType = "_target_type"
 	Internal_TargetID   = "_target_id"
+	// Internal_Version increases with every write to the node or relation
+	Internal_Version = "_version"
 )
 
 func getNodePath(typee, id string) []byte {
-	key := append([]string{string(nodesPrefix)})
+	key := []string{nodesPrefix}
 	if typee != "" {
 		key = append(key, typee)
 	}
@@ -57,6 +67,12 @@ func getNodeRelationPath(sourceType, sourceID string, direction api.Direction, r
 	return []byte(strings.Join(key, ","))
 }
 
+// getNodeRelationsPrefix returns the prefix shared by every relation connected to the node in either direction
+func getNodeRelationsPrefix(nodeType, nodeID string) []byte {
+	key := []string{nodeRelationPrefix, nodeType, nodeID, ""}
+	return []byte(strings.Join(key, ","))
+}
+
 func getRelationID(sourceType, sourceID string, relation, targetType, targetID string) string {
 	key := append([]string{string(nodeRelationPrefix)}, sourceType, sourceID, relation, targetType, targetID)
 	s := sha1.New()
@@ -70,40 +86,151 @@ func getRelationPath(relation, id string) []byte {
 	return []byte(strings.Join(key, ","))
 }
 
+func getCatalogPath(entry ...string) []byte {
+	key := append([]string{catalogPrefix}, entry...)
+	return []byte(strings.Join(key, ","))
+}
+
+func getMetaPath(key string) []byte {
+	return []byte(strings.Join([]string{metaPrefix, key}, ","))
+}
+
+// getCountPath returns the key of the counter of the nodes of a type(nodesPrefix) or relations of a type(relationPrefix)
+func getCountPath(prefix, typee string) []byte {
+	return getMetaPath(strings.Join([]string{"count", prefix, typee}, ","))
+}
+
+// getDefinitionPath returns the key of the definition of a node type(nodesPrefix) or relation(relationPrefix)
+func getDefinitionPath(prefix, typee string) []byte {
+	return []byte(strings.Join([]string{definitionPrefix, prefix, typee}, ","))
+}
+
+// getNodeTypeFieldPrefix returns the prefix shared by every field index key of the node type's field
+func getNodeTypeFieldPrefix(nodeType, field string) []byte {
+	key := []string{nodeFieldsPrefix, nodeType, field, ""}
+	return []byte(strings.Join(key, ","))
+}
+
 func getNodeTypeFieldPath(nodeType, field string, fieldValue interface{}, nodeID string) []byte {
-	key := append([]string{string(nodeFieldsPrefix)}, nodeType, field)
-	if fieldValue != nil {
-		key = append(key, fmt.Sprint(fieldValue))
-	}
-	if nodeID != "" {
-		key = append(key, nodeID)
+	return getFieldPath(getNodeTypeFieldPrefix(nodeType, field), fieldValue, nodeID)
+}
+
+// getNodeTypeFieldPaths returns the field index keys of every property of the node
+func getNodeTypeFieldPaths(nodeType string, properties map[string]interface{}, nodeID string) [][]byte {
+	var keys [][]byte
+	for k, v := range properties {
+		fieldIndexValues(k, v, func(field string, value interface{}) {
+			keys = append(keys, getNodeTypeFieldPath(nodeType, field, value, nodeID))
+		})
 	}
+	return keys
+}
+
+// getRelationFieldPrefix returns the prefix shared by every field index key of the relation's field
+func getRelationFieldPrefix(relation, field string) []byte {
+	key := []string{relationFieldsPrefix, relation, field, ""}
 	return []byte(strings.Join(key, ","))
 }
 
 func getRelationFieldPath(relation, field string, fieldValue interface{}, relationID string) []byte {
-	key := append([]string{string(relationFieldsPrefix)}, relation, field, fmt.Sprint(fieldValue), relationID)
-	return []byte(strings.Join(key, ","))
+	return getFieldPath(getRelationFieldPrefix(relation, field), fieldValue, relationID)
 }
 
-func parseCursor(cursor string) (int, error) {
-	bits, err := base64.StdEncoding.DecodeString(cursor)
-	if err != nil {
-		return 0, err
+// getRelationFieldPaths returns the field index keys of every property of the relation
+func getRelationFieldPaths(relation string, properties map[string]interface{}, relationID string) [][]byte {
+	var keys [][]byte
+	for k, v := range properties {
+		fieldIndexValues(k, v, func(field string, value interface{}) {
+			keys = append(keys, getRelationFieldPath(relation, field, value, relationID))
+		})
 	}
-	split := strings.Split(string(bits), "cursor-")
-	if len(split) < 2 {
-		return 0, fmt.Errorf("bad cursor")
+	return keys
+}
+
+func getFieldPath(fieldPrefix []byte, fieldValue interface{}, id string) []byte {
+	key := append(append([]byte{}, fieldPrefix...), encodeIndexValue(fieldValue)...)
+	return append(key, []byte(fmt.Sprintf(",%s", id))...)
+}
+
+// fieldIndexValues calls fn with the field & value of each field index key of the property. Arrays are indexed once
+// per element & maps are indexed by the dotted path of each of their values.
+func fieldIndexValues(field string, value interface{}, fn func(field string, value interface{})) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+		iter := rv.MapRange()
+		for iter.Next() {
+			fieldIndexValues(fmt.Sprintf("%s.%s", field, iter.Key().String()), iter.Value().Interface(), fn)
+		}
+		return
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		for i := 0; i < rv.Len(); i++ {
+			fieldIndexValues(field, rv.Index(i).Interface(), fn)
+		}
+		return
 	}
-	return strconv.Atoi(split[1])
+	fn(field, value)
 }
-func createCursor(skip int) string {
-	cursor := fmt.Sprintf("cursor-%v", skip)
-	str := base64.StdEncoding.EncodeToString([]byte(cursor))
-	return str
+
+// isCollection returns whether the value is a map or an array, which are never answered by a field index
+func isCollection(value interface{}) bool {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Map, reflect.Array:
+		return true
+	case reflect.Slice:
+		return reflect.TypeOf(value).Elem().Kind() != reflect.Uint8
+	}
+	return false
+}
+
+// getIndexedID returns the node or relation id at the end of a field index key
+func getIndexedID(key []byte) string {
+	return string(key[bytes.LastIndexByte(key, ',')+1:])
 }
 
-func eval(exp *model.Expression, ent api.Entity) (bool, error) {
+// compileRegexes compiles the pattern of every REGEX expression in the expressions & filters once, before any
+// entities are evaluated, so invalid patterns fail up front. The regexes are keyed by pattern.
+func compileRegexes(expressions []*model.Expression, filters ...*model.Filter) (map[string]*regexp.Regexp, error) {
+	regexes := map[string]*regexp.Regexp{}
+	var compile func(expressions []*model.Expression, filters []*model.Filter) error
+	compile = func(expressions []*model.Expression, filters []*model.Filter) error {
+		for _, exp := range expressions {
+			if exp.Operator != model.OperatorRegex {
+				continue
+			}
+			pattern := cast.ToString(exp.Value)
+			if _, ok := regexes[pattern]; ok {
+				continue
+			}
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return stacktrace.Propagate(err, "invalid regex for key: %s", exp.Key)
+			}
+			regexes[pattern] = re
+		}
+		for _, filter := range filters {
+			if filter == nil {
+				continue
+			}
+			if err := compile(filter.Expressions, append(append(append([]*model.Filter{}, filter.And...), filter.Or...), filter.Not)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := compile(expressions, filters); err != nil {
+		return nil, err
+	}
+	return regexes, nil
+}
+
+func eval(exp *model.Expression, ent api.Entity, regexes map[string]*regexp.Regexp) (bool, error) {
 	val, err := ent.GetProperty(exp.Key)
 	if err != nil {
 		return false, err
@@ -119,7 +246,7 @@ func eval(exp *model.Expression, ent api.Entity) (bool, error) {
 				Key:      strings.TrimPrefix(exp.Key, "_source."),
 				Operator: exp.Operator,
 				Value:    exp.Value,
-			}, source)
+			}, source, regexes)
 		}
 		if strings.HasPrefix(exp.Key, "_target.") {
 			target, err := v.Target()
@@ -130,29 +257,190 @@ func eval(exp *model.Expression, ent api.Entity) (bool, error) {
 				Key:      strings.TrimPrefix(exp.Key, "_target."),
 				Operator: exp.Operator,
 				Value:    exp.Value,
-			}, target)
+			}, target, regexes)
 		}
 	}
 
+	// values are compared by kind the same way they are ordered in the field indexes, so the result of an expression
+	// doesn't depend on whether it was answered by an index or a scan
 	switch exp.Operator {
-	case model.OperatorEq:
-		return val == exp.Value, nil
-	case model.OperatorNeq:
-		return val != exp.Value, nil
-	case model.OperatorGt:
-		return cast.ToFloat64(val) > cast.ToFloat64(exp.Value), nil
-	case model.OperatorLt:
-		return cast.ToFloat64(val) < cast.ToFloat64(exp.Value), nil
-	case model.OperatorGte:
-		return cast.ToFloat64(val) >= cast.ToFloat64(exp.Value), nil
-	case model.OperatorLte:
-		return cast.ToFloat64(val) <= cast.ToFloat64(exp.Value), nil
-	case model.OperatorContains:
-		return strings.Contains(cast.ToString(val), cast.ToString(exp.Value)), nil
-	case model.OperatorHasPrefix:
-		return strings.HasPrefix(cast.ToString(val), cast.ToString(exp.Value)), nil
-	case model.OperatorHasSuffix:
-		return strings.HasSuffix(cast.ToString(val), cast.ToString(exp.Value)), nil
+	case model.OperatorEq, model.OperatorNeq:
+		cmp, ok := compareValues(val, exp.Value)
+		equal := ok && cmp == 0
+		if exp.Operator == model.OperatorNeq {
+			return !equal, nil
+		}
+		return equal, nil
+	case model.OperatorGt, model.OperatorLt, model.OperatorGte, model.OperatorLte:
+		cmp, ok := compareValues(val, exp.Value)
+		if !ok {
+			return false, nil
+		}
+		switch exp.Operator {
+		case model.OperatorGt:
+			return cmp > 0, nil
+		case model.OperatorLt:
+			return cmp < 0, nil
+		case model.OperatorGte:
+			return cmp >= 0, nil
+		default:
+			return cmp <= 0, nil
+		}
+	case model.OperatorIn, model.OperatorNotIn:
+		values, err := listValue(exp)
+		if err != nil {
+			return false, err
+		}
+		var in bool
+		for _, value := range values {
+			if cmp, ok := compareValues(val, value); ok && cmp == 0 {
+				in = true
+				break
+			}
+		}
+		return in == (exp.Operator == model.OperatorIn), nil
+	case model.OperatorContainsAny, model.OperatorContainsAll:
+		values, err := listValue(exp)
+		if err != nil {
+			return false, err
+		}
+		// a property that isn't an array is treated as an array of itself
+		elements, ok := toList(val)
+		if !ok && val != nil {
+			elements = []interface{}{val}
+		}
+		for _, value := range values {
+			var found bool
+			for _, element := range elements {
+				if cmp, ok := compareValues(element, value); ok && cmp == 0 {
+					found = true
+					break
+				}
+			}
+			if found && exp.Operator == model.OperatorContainsAny {
+				return true, nil
+			}
+			if !found && exp.Operator == model.OperatorContainsAll {
+				return false, nil
+			}
+		}
+		return exp.Operator == model.OperatorContainsAll, nil
+	case model.OperatorExists:
+		return val != nil, nil
+	case model.OperatorNotExists:
+		return val == nil, nil
+	case model.OperatorBetween:
+		low, high, err := betweenValues(exp)
+		if err != nil {
+			return false, err
+		}
+		lowCmp, ok := compareValues(val, low)
+		if !ok {
+			return false, nil
+		}
+		highCmp, ok := compareValues(val, high)
+		if !ok {
+			return false, nil
+		}
+		return lowCmp >= 0 && highCmp <= 0, nil
+	case model.OperatorRegex:
+		str, ok := val.(string)
+		if !ok {
+			return false, nil
+		}
+		re, ok := regexes[cast.ToString(exp.Value)]
+		if !ok {
+			return false, stacktrace.NewError("regex for key %s was not compiled", exp.Key)
+		}
+		return re.MatchString(str), nil
+	case model.OperatorContains, model.OperatorHasPrefix, model.OperatorHasSuffix:
+		str, ok := val.(string)
+		if !ok {
+			return false, nil
+		}
+		switch exp.Operator {
+		case model.OperatorContains:
+			return strings.Contains(str, cast.ToString(exp.Value)), nil
+		case model.OperatorHasPrefix:
+			return strings.HasPrefix(str, cast.ToString(exp.Value)), nil
+		default:
+			return strings.HasSuffix(str, cast.ToString(exp.Value)), nil
+		}
 	}
 	return false, nil
 }
+
+// evalFilter returns whether the entity matches every expression & group of the filter. A nil filter matches everything.
+func evalFilter(filter *model.Filter, ent api.Entity, regexes map[string]*regexp.Regexp) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+	passed, err := evalAll(filter.Expressions, ent, regexes)
+	if err != nil || !passed {
+		return false, err
+	}
+	for _, and := range filter.And {
+		passed, err := evalFilter(and, ent, regexes)
+		if err != nil || !passed {
+			return false, err
+		}
+	}
+	if len(filter.Or) > 0 {
+		var matched bool
+		for _, or := range filter.Or {
+			passed, err := evalFilter(or, ent, regexes)
+			if err != nil {
+				return false, err
+			}
+			if passed {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	if filter.Not != nil {
+		passed, err := evalFilter(filter.Not, ent, regexes)
+		if err != nil {
+			return false, err
+		}
+		return !passed, nil
+	}
+	return true, nil
+}
+
+// listValue returns the values of an expression whose operator expects a list
+func listValue(exp *model.Expression) ([]interface{}, error) {
+	values, ok := toList(exp.Value)
+	if !ok {
+		return nil, stacktrace.NewError("%s expects a list value for key: %s", exp.Operator, exp.Key)
+	}
+	return values, nil
+}
+
+// toList returns the elements of the value & whether it is an array
+func toList(value interface{}) ([]interface{}, bool) {
+	if !isCollection(value) || reflect.ValueOf(value).Kind() == reflect.Map {
+		return nil, false
+	}
+	rv := reflect.ValueOf(value)
+	values := make([]interface{}, rv.Len())
+	for i := range values {
+		values[i] = rv.Index(i).Interface()
+	}
+	return values, true
+}
+
+// betweenValues returns the inclusive bounds of a BETWEEN expression
+func betweenValues(exp *model.Expression) (interface{}, interface{}, error) {
+	values, err := listValue(exp)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(values) != 2 {
+		return nil, nil, stacktrace.NewError("%s expects a [low, high] value for key: %s", exp.Operator, exp.Key)
+	}
+	return values[0], values[1], nil
+}
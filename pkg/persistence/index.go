@@ -0,0 +1,399 @@
+package persistence
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/palantir/stacktrace"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// indexVersion is incremented whenever the encoding of field index keys changes. Field indexes are rebuilt from the
+// nodes & relations in storage when the stored version differs.
+const indexVersion = "4"
+
+// index value tags - values of different kinds never compare as equal and sort by tag
+const (
+	tagNull byte = iota + 1
+	tagBool
+	tagNumber
+	tagTime
+	tagString
+	tagOther
+)
+
+// encodeIndexValue encodes a property value so that the byte-wise order of encoded values of the same kind matches
+// the natural order of the values. Integers and floats share a single numeric encoding so they compare with each other.
+// Times are encoded with the millisecond precision they're stored with. No encoded value is a prefix of another.
+func encodeIndexValue(value interface{}) []byte {
+	switch v := value.(type) {
+	case nil:
+		return []byte{tagNull}
+	case bool:
+		if v {
+			return []byte{tagBool, 1}
+		}
+		return []byte{tagBool, 0}
+	case string:
+		return encodeIndexString(tagString, v)
+	case time.Time:
+		return encodeIndexTime(primitive.NewDateTimeFromTime(v))
+	case primitive.DateTime:
+		return encodeIndexTime(v)
+	case primitive.Timestamp:
+		return encodeIndexTime(primitive.DateTime(int64(v.T) * 1e3))
+	}
+	if f, ok := toFloat(value); ok {
+		return encodeIndexNumber(f, intRemainder(value, f))
+	}
+	return encodeIndexString(tagOther, fmt.Sprint(value))
+}
+
+// encodeIndexNumber encodes the float followed by the remainder of an integer that the float doesn't represent
+// exactly, so integers beyond 2^53 that round to the same float still have distinct keys that sort in order
+func encodeIndexNumber(f float64, remainder int16) []byte {
+	if f == 0 {
+		// -0 & 0 are equal
+		f = 0
+	}
+	bits := math.Float64bits(f)
+	if f >= 0 {
+		bits ^= 1 << 63
+	} else {
+		bits = ^bits
+	}
+	buf := make([]byte, 11)
+	buf[0] = tagNumber
+	binary.BigEndian.PutUint64(buf[1:], bits)
+	binary.BigEndian.PutUint16(buf[9:], uint16(remainder)^(1<<15))
+	return buf
+}
+
+// intRemainder returns the difference between the integer value & the float it was rounded to, which is never more
+// than half of the float's precision, or 0 if the value is not an integer
+func intRemainder(value interface{}, f float64) int16 {
+	if math.Abs(f) < 1<<53 || math.IsInf(f, 0) {
+		return 0
+	}
+	exact := new(big.Int)
+	switch v := value.(type) {
+	case int:
+		exact.SetInt64(int64(v))
+	case int64:
+		exact.SetInt64(v)
+	case uint:
+		exact.SetUint64(uint64(v))
+	case uint64:
+		exact.SetUint64(v)
+	case json.Number:
+		if _, ok := exact.SetString(v.String(), 10); !ok {
+			return 0
+		}
+	default:
+		return 0
+	}
+	rounded, _ := new(big.Float).SetFloat64(f).Int(nil)
+	return int16(exact.Sub(exact, rounded).Int64())
+}
+
+func encodeIndexTime(t primitive.DateTime) []byte {
+	buf := make([]byte, 9)
+	buf[0] = tagTime
+	binary.BigEndian.PutUint64(buf[1:], uint64(t)^(1<<63))
+	return buf
+}
+
+// encodeIndexString escapes 0x00 bytes as 0x00 0xFF and terminates the string with 0x00 0x01
+func encodeIndexString(tag byte, s string) []byte {
+	buf := encodeIndexStringPrefix(tag, s)
+	return append(buf, 0x00, 0x01)
+}
+
+// encodeIndexStringPrefix encodes the string without its terminator so that it is a prefix of every encoded string
+// that starts with s
+func encodeIndexStringPrefix(tag byte, s string) []byte {
+	buf := make([]byte, 0, len(s)+3)
+	buf = append(buf, tag)
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0x00 {
+			buf = append(buf, 0x00, 0xFF)
+			continue
+		}
+		buf = append(buf, s[i])
+	}
+	return buf
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// compareValues compares two property values using their index encoding. ok is false if the values are of different
+// kinds and therefore cannot be compared.
+func compareValues(a, b interface{}) (int, bool) {
+	encA, encB := encodeIndexValue(a), encodeIndexValue(b)
+	if encA[0] != encB[0] {
+		return 0, false
+	}
+	return bytes.Compare(encA, encB), true
+}
+
+// prefixEnd returns the smallest key that is greater than every key with the given prefix
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xFF {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// indexRanges returns the ranges of the field index keys(with the given field prefix) that may satisfy the expression,
+// or nil if the expression cannot be answered by the field index.
+func indexRanges(fieldPrefix []byte, exp *model.Expression) *indexPlan {
+	rng := func(start, end []byte) keyRange {
+		return keyRange{start: start, end: end, fieldPrefix: fieldPrefix, field: exp.Key}
+	}
+	join := func(parts ...[]byte) []byte {
+		return bytes.Join(append([][]byte{fieldPrefix}, parts...), nil)
+	}
+	switch exp.Operator {
+	case model.OperatorHasPrefix:
+		prefix, isString := exp.Value.(string)
+		if !isString {
+			return nil
+		}
+		start := join(encodeIndexStringPrefix(tagString, prefix))
+		return &indexPlan{ranges: keyRanges{rng(start, prefixEnd(start))}, rank: rankRange}
+	case model.OperatorIn, model.OperatorContainsAny:
+		values, err := listValue(exp)
+		if err != nil {
+			return nil
+		}
+		// each distinct value is a separate range, iterated in index order
+		encoded := map[string]struct{}{}
+		for _, value := range values {
+			if isCollection(value) {
+				return nil
+			}
+			encoded[string(encodeIndexValue(value))] = struct{}{}
+		}
+		keys := make([]string, 0, len(encoded))
+		for key := range encoded {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		plan := &indexPlan{rank: rankIn}
+		for _, key := range keys {
+			equal := join([]byte(key), []byte(","))
+			plan.ranges = append(plan.ranges, rng(equal, prefixEnd(equal)))
+		}
+		return plan
+	case model.OperatorBetween:
+		low, high, err := betweenValues(exp)
+		if err != nil {
+			return nil
+		}
+		lowVal, highVal := encodeIndexValue(low), encodeIndexValue(high)
+		if lowVal[0] != highVal[0] || lowVal[0] == tagOther {
+			return nil
+		}
+		return &indexPlan{
+			ranges: keyRanges{rng(join(lowVal, []byte(",")), prefixEnd(join(highVal, []byte(","))))},
+			rank:   rankBetween,
+		}
+	case model.OperatorContainsAll:
+		// every value must be an element, so the elements matching the first value are enough
+		values, err := listValue(exp)
+		if err != nil || len(values) == 0 || isCollection(values[0]) {
+			return nil
+		}
+		equal := join(encodeIndexValue(values[0]), []byte(","))
+		return &indexPlan{ranges: keyRanges{rng(equal, prefixEnd(equal))}, rank: rankEq}
+	case model.OperatorEq, model.OperatorGt, model.OperatorGte, model.OperatorLt, model.OperatorLte:
+	default:
+		return nil
+	}
+	if isCollection(exp.Value) {
+		return nil
+	}
+	val := encodeIndexValue(exp.Value)
+	if val[0] == tagOther && exp.Operator != model.OperatorEq {
+		return nil
+	}
+	var (
+		kindStart = join([]byte{val[0]})
+		kindEnd   = join([]byte{val[0] + 1})
+		equal     = join(val, []byte(","))
+	)
+	plan := func(start, end []byte) *indexPlan {
+		rank := rankRange
+		if exp.Operator == model.OperatorEq {
+			rank = rankEq
+		}
+		return &indexPlan{ranges: keyRanges{rng(start, end)}, rank: rank}
+	}
+	switch exp.Operator {
+	case model.OperatorEq:
+		return plan(equal, prefixEnd(equal))
+	case model.OperatorGt:
+		return plan(prefixEnd(equal), kindEnd)
+	case model.OperatorGte:
+		return plan(equal, kindEnd)
+	case model.OperatorLt:
+		return plan(kindStart, equal)
+	default:
+		return plan(kindStart, prefixEnd(equal))
+	}
+}
+
+// migrateIndexes rebuilds the node & relation field indexes & the unique indexes if they were written with a different
+// index encoding. Definitions must be loaded first.
+func (d *DB) migrateIndexes() error {
+	versionKey := getMetaPath("index_version")
+	var (
+		version string
+		empty   = true
+	)
+	if err := d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(versionKey)
+		if err != nil && err != badger.ErrKeyNotFound {
+			return stacktrace.Propagate(err, "")
+		}
+		if item != nil {
+			val, err := item.ValueCopy(nil)
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+			version = string(val)
+		}
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		for _, prefix := range [][]byte{[]byte(nodesPrefix), []byte(relationPrefix)} {
+			it.Seek(prefix)
+			if it.ValidForPrefix(prefix) {
+				empty = false
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if version == indexVersion {
+		return nil
+	}
+	if !empty {
+		if err := d.db.DropPrefix([]byte(nodeFieldsPrefix), []byte(relationFieldsPrefix)); err != nil {
+			return stacktrace.Propagate(err, "failed to drop field indexes")
+		}
+		for _, prefix := range []string{nodesPrefix, relationPrefix} {
+			if err := d.reindex(prefix); err != nil {
+				return stacktrace.Propagate(err, "failed to rebuild field indexes")
+			}
+		}
+		if err := d.db.DropPrefix([]byte(uniquePrefix + ",")); err != nil {
+			return stacktrace.Propagate(err, "failed to drop unique indexes")
+		}
+		var err error
+		d.nodeDefinitions.Range(func(key, value interface{}) bool {
+			for _, fields := range value.(*definition).unique {
+				if err = d.update(func(t *tx) error {
+					return d.buildUnique(t, key.(string), fields)
+				}); err != nil {
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return stacktrace.Propagate(err, "failed to rebuild unique indexes")
+		}
+	}
+	return d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(versionKey, []byte(indexVersion))
+	})
+}
+
+func (d *DB) reindex(prefix string) error {
+	batch := d.db.NewWriteBatch()
+	if err := d.db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchSize = prefetchSize
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		for it.Seek([]byte(prefix)); it.ValidForPrefix([]byte(prefix)); it.Next() {
+			item := it.Item()
+			split := strings.Split(string(item.Key()), ",")
+			if len(split) < 3 {
+				continue
+			}
+			bits, err := item.ValueCopy(nil)
+			if err != nil {
+				return stacktrace.Propagate(err, "key=%s", string(item.Key()))
+			}
+			data := map[string]interface{}{}
+			if err := encode.Unmarshal(bits, &data); err != nil {
+				return stacktrace.Propagate(err, "key=%s", string(item.Key()))
+			}
+			var keys [][]byte
+			if prefix == nodesPrefix {
+				keys = getNodeTypeFieldPaths(split[1], data, split[len(split)-1])
+			} else {
+				keys = getRelationFieldPaths(split[1], data, split[len(split)-1])
+			}
+			for _, key := range keys {
+				if err := batch.Set(key, bits); err != nil {
+					return stacktrace.Propagate(err, "")
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		batch.Cancel()
+		return stacktrace.Propagate(err, "")
+	}
+	return batch.Flush()
+}
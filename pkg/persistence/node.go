@@ -5,11 +5,9 @@ import (
 	"github.com/autom8ter/morpheus/pkg/constants"
 	"github.com/autom8ter/morpheus/pkg/encode"
 	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/autom8ter/morpheus/pkg/helpers"
 	"github.com/dgraph-io/badger/v3"
 	"github.com/palantir/stacktrace"
-	"github.com/spf13/cast"
-	"sort"
-	"strings"
 )
 
 type Node struct {
@@ -59,7 +57,7 @@ func (n Node) GetProperty(name string) (interface{}, error) {
 	if all == nil {
 		return nil, stacktrace.Propagate(constants.ErrNotFound, "")
 	}
-	return all[name], nil
+	return helpers.Lookup(all, name), nil
 }
 
 func (n Node) SetProperties(properties map[string]interface{}) error {
@@ -73,127 +71,41 @@ func (n Node) SetProperties(properties map[string]interface{}) error {
 	return nil
 }
 
-func (n Node) DelProperty(name string) error {
-	all, err := n.Properties()
-	if err != nil {
+func (n Node) PatchProperties(patch *model.Patch) error {
+	if err := n.db.update(func(t *tx) error {
+		_, err := n.db.patchNode(t, n.nodeType, n.nodeID, patch)
+		return err
+	}); err != nil {
 		return stacktrace.Propagate(err, "")
 	}
-	delete(all, name)
-	if err := n.SetProperties(all); err != nil {
+	return nil
+}
+
+func (n Node) DelProperty(name string) error {
+	if err := n.PatchProperties(&model.Patch{Set: map[string]interface{}{name: nil}}); err != nil {
 		return stacktrace.Propagate(err, "")
 	}
 	return nil
 }
 
 func (n Node) AddRelation(direction api.Direction, relation string, properties map[string]interface{}, node api.Node) (api.Relation, error) {
-	if properties == nil {
-		properties = map[string]interface{}{}
-	}
-	relID := getRelationID(n.Type(), n.ID(), relation, node.Type(), node.ID())
-	n.db.relationTypes.Store(relation, struct{}{})
-	rkey := getRelationPath(relation, relID)
-	var sourceNode api.Node
-	var targetNode api.Node
-	if direction == api.Outgoing {
-		targetNode = node
-		sourceNode = n
-	} else {
-		targetNode = n
-		sourceNode = node
-	}
-	source := getNodeRelationPath(sourceNode.Type(), sourceNode.ID(), direction, relation, targetNode.Type(), targetNode.ID(), relID)
-	target := getNodeRelationPath(targetNode.Type(), targetNode.ID(), direction.Opposite(), relation, sourceNode.Type(), sourceNode.ID(), relID)
-
-	properties[Internal_Direction] = direction
-	properties[Internal_SourceType] = sourceNode.Type()
-	properties[Internal_SourceID] = sourceNode.ID()
-	properties[Internal_TargetType] = targetNode.Type()
-	properties[Internal_TargetID] = targetNode.ID()
-	properties[Internal_ID] = relID
-	properties[Internal_Relation] = relation
-	properties[Internal_Type] = relation
-
-	bits, err := encode.Marshal(&properties)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "")
-	}
-	if err := n.db.db.Update(func(txn *badger.Txn) error {
-		if err := txn.Set(rkey, bits); err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-		if err := txn.Set(source, bits); err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-		if err := txn.Set(target, bits); err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-		for k, v := range properties {
-			n.db.relationFieldMap.Store(strings.Join([]string{relation, k}, ","), struct{}{})
-			key := getRelationFieldPath(relation, k, v, relID)
-			if err := txn.Set(key, bits); err != nil {
-				return stacktrace.Propagate(err, "")
-			}
-		}
-		return nil
+	var r *Relation
+	if err := n.db.update(func(t *tx) error {
+		var err error
+		r, err = n.db.addRelation(t, direction, relation, properties, model.Key{Type: n.Type(), ID: n.ID()}, model.Key{Type: node.Type(), ID: node.ID()})
+		return err
 	}); err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
-	r := &Relation{
-		relationType: relation,
-		relationID:   relID,
-		item:         properties,
-		db:           n.db,
-	}
-	n.db.cache.Set(string(rkey), r, 1)
 	return r, nil
 }
 
 func (n Node) DelRelation(relation string, id string) error {
-	rel, ok, err := n.GetRelation(relation, id)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-	if !ok {
-		return stacktrace.Propagate(constants.ErrNotFound, "")
-	}
-	targetNode, err := rel.Target()
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-
-	rkey := getRelationPath(rel.Type(), rel.ID())
-	source := getNodeRelationPath(n.Type(), n.ID(), api.Outgoing, relation, targetNode.Type(), targetNode.ID(), rel.ID())
-	target := getNodeRelationPath(targetNode.Type(), targetNode.ID(), api.Incoming, relation, n.Type(), n.ID(), rel.ID())
-	if err := n.db.db.Update(func(txn *badger.Txn) error {
-		if err := txn.Delete(rkey); err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-		if err := txn.Delete(source); err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-		if err := txn.Delete(target); err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-		props, err := rel.Properties()
-		if err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-		if err := n.db.db.View(func(txn *badger.Txn) error {
-			for k, v := range props {
-				key := getRelationFieldPath(rel.Type(), k, v, rel.ID())
-				if err := txn.Delete(key); err != nil {
-					return stacktrace.Propagate(err, "")
-				}
-			}
-			return nil
-		}); err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-		return nil
+	if err := n.db.update(func(t *tx) error {
+		return n.db.deleteRelation(t, relation, id)
 	}); err != nil {
 		return stacktrace.Propagate(err, "")
 	}
-	n.db.cache.Del(string(rkey))
 	return nil
 }
 
@@ -231,112 +143,85 @@ func (n Node) GetRelation(relation, id string) (api.Relation, bool, error) {
 
 func (n Node) Relations(where *model.RelationWhere) (string, []api.Relation, error) {
 	source := getNodeRelationPath(n.Type(), n.ID(), api.Direction(where.Direction), where.Relation, where.TargetType, "", "")
-	// Iterate over 1000 items
 	var (
-		skipped int
-		skip    int
-		rels    []api.Relation
-		err     error
+		last *cursor
+		rels []api.Relation
 	)
-	if where.Cursor != nil {
-		skip, err = parseCursor(*where.Cursor)
-		if err != nil {
-			return "", nil, stacktrace.Propagate(err, "")
-		}
+	after, err := parseCursor(where.Cursor)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
 	}
 	if where.PageSize == nil {
 		defaultSize := prefetchSize
 		where.PageSize = &defaultSize
 	}
-
+	regexes, err := compileRegexes(where.Expressions, where.Filter)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+	// relations are iterated over in the order of their node-relation keys, so they can only be ordered by sorting
+	sorter := newBoundedSort(where.OrderBy, *where.PageSize, false)
+	if sorter != nil {
+		if err := sorter.resume(after); err != nil {
+			return "", nil, stacktrace.Propagate(err, "")
+		}
+		after = nil
+	}
+	kr := keyRanges{{start: source, end: prefixEnd(source)}}
 	if err := n.db.db.View(func(txn *badger.Txn) error {
-		opt := badger.DefaultIteratorOptions
-		opt.PrefetchSize = prefetchSize
-		it := txn.NewIterator(opt)
-		defer it.Close()
-		for it.Seek(source); it.ValidForPrefix(source); it.Next() {
-			if len(rels) >= *where.PageSize {
-				return nil
-			}
-			if skipped < skip {
-				skipped++
-				continue
-			}
-			item := it.Item()
-			split := strings.Split(string(item.Key()), ",")
+		last, err = kr.iterate(txn, after, func(i int, item *badger.Item) (bool, error) {
+			relationID := getIndexedID(item.Key())
 			var rel api.Relation
-			cached, ok := n.db.cache.Get(string(getRelationPath(where.Relation, split[len(split)-1])))
+			cached, ok := n.db.cache.Get(string(getRelationPath(where.Relation, relationID)))
 			if ok {
 				rel = cached.(api.Relation)
 			} else {
 				rel = &Relation{
 					relationType: where.Relation,
-					relationID:   split[len(split)-1],
+					relationID:   relationID,
 					item:         nil,
 					db:           n.db,
 				}
 			}
-			passed := true
-			if len(where.Expressions) > 0 {
-				for _, exp := range where.Expressions {
-					passed, err = eval(exp, rel)
-					if err != nil {
-						return stacktrace.Propagate(err, "")
-					}
-					if !passed {
-						break
-					}
-				}
+			passed, err := matches(where.Expressions, where.Filter, rel, regexes)
+			if err != nil {
+				return false, stacktrace.Propagate(err, "")
+			}
+			if passed && sorter != nil {
+				return true, sorter.add(rel)
 			}
 			if passed {
 				rels = append(rels, rel)
 			}
-		}
-		return nil
+			return len(rels) < *where.PageSize, nil
+		})
+		return err
 	}); err != nil {
 		return "", nil, stacktrace.Propagate(err, "")
 	}
-	if len(rels) > 0 {
-		if where.OrderBy == nil {
-			sort.Slice(rels, func(i, j int) bool {
-				return rels[i].ID() < rels[j].ID()
-			})
-		} else {
-			sort.Slice(rels, func(i, j int) bool {
-				switch where.OrderBy.Field {
-				case "type":
-					if where != nil && where.OrderBy.Reverse != nil && *where.OrderBy.Reverse {
-						return rels[i].Type() > rels[j].Type()
-					}
-					return rels[i].Type() < rels[j].Type()
-				default:
-					iprops, err := rels[i].Properties()
-					if err != nil {
-						return true
-					}
-					if iprops[where.OrderBy.Field] == nil {
-						return true
-					}
-					jprops, err := rels[j].Properties()
-					if err != nil {
-						return false
-					}
-					if jprops[where.OrderBy.Field] == nil {
-						return true
-					}
-					if where.OrderBy.Reverse != nil && *where.OrderBy.Reverse {
-						if val, err := cast.ToFloat64E(iprops[where.OrderBy.Field]); err == nil {
-							return val > cast.ToFloat64(jprops[where.OrderBy.Field])
-						}
-						return cast.ToString(iprops[where.OrderBy.Field]) < cast.ToString(jprops[where.OrderBy.Field])
-					}
-					if val, err := cast.ToFloat64E(iprops[where.OrderBy.Field]); err == nil {
-						return val < cast.ToFloat64(jprops[where.OrderBy.Field])
-					}
-					return cast.ToString(iprops[where.OrderBy.Field]) < cast.ToString(jprops[where.OrderBy.Field])
-				}
-			})
+	if sorter != nil {
+		for _, ent := range sorter.page() {
+			rels = append(rels, ent.(api.Relation))
 		}
+		return nextCursor(sorter.cursor(), where.Cursor), rels, nil
+	}
+	return nextCursor(last, where.Cursor), rels, nil
+}
+
+func (n Node) CountRelations(where *model.RelationWhere) (int, error) {
+	source := getNodeRelationPath(n.Type(), n.ID(), api.Direction(where.Direction), where.Relation, where.TargetType, "", "")
+	var (
+		kr    = keyRanges{{start: source, end: prefixEnd(source)}}
+		count int
+	)
+	if err := n.db.db.View(func(txn *badger.Txn) error {
+		var err error
+		count, err = countRange(txn, kr, unfiltered(where.Expressions, where.Filter), where.Expressions, where.Filter, func(item *badger.Item) (api.Entity, error) {
+			return n.db.itemRelation(where.Relation, item)
+		})
+		return err
+	}); err != nil {
+		return 0, stacktrace.Propagate(err, "")
 	}
-	return createCursor(skipped + skip), rels, nil
+	return count, nil
 }
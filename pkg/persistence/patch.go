@@ -0,0 +1,150 @@
+package persistence
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/palantir/stacktrace"
+	"reflect"
+)
+
+// patchProperties returns the properties that result from applying the patch to a copy of the existing properties.
+// The patch's set values are merged first, followed by its increments, appends & removals.
+func patchProperties(existing map[string]interface{}, patch *model.Patch) (map[string]interface{}, error) {
+	if patch == nil {
+		patch = &model.Patch{}
+	}
+	props := mergePatch(existing, patch.Set)
+	for field, delta := range patch.Increment {
+		value, err := increment(props[field], delta)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "failed to increment %s", field)
+		}
+		props[field] = value
+	}
+	for field, values := range patch.Append {
+		value, err := appendValues(props[field], values)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "failed to append to %s", field)
+		}
+		props[field] = value
+	}
+	for field, values := range patch.Remove {
+		value, err := removeValues(props[field], values)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "failed to remove from %s", field)
+		}
+		if value != nil {
+			props[field] = value
+		}
+	}
+	return props, nil
+}
+
+// mergePatch merges the patch into a copy of the properties like a JSON merge patch: null values delete the property
+// & maps are merged into the existing map
+func mergePatch(properties, patch map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(properties)+len(patch))
+	for k, v := range properties {
+		merged[k] = v
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(merged, k)
+			continue
+		}
+		if m, ok := toMap(v); ok {
+			existing, _ := toMap(merged[k])
+			merged[k] = mergePatch(existing, m)
+			continue
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// increment adds delta to the numeric value. Integers stay integers unless the delta is a float.
+func increment(value, delta interface{}) (interface{}, error) {
+	if value == nil {
+		value = 0
+	}
+	a, ok := toFloat(value)
+	if !ok {
+		return nil, stacktrace.NewError("%v is not a number", value)
+	}
+	b, ok := toFloat(delta)
+	if !ok {
+		return nil, stacktrace.NewError("%v is not a number", delta)
+	}
+	if isInt(value) && isInt(delta) {
+		return int64(a) + int64(b), nil
+	}
+	return a + b, nil
+}
+
+func isInt(value interface{}) bool {
+	if n, ok := value.(json.Number); ok {
+		_, err := n.Int64()
+		return err == nil
+	}
+	return valueKind(value) == model.ValueKindInt
+}
+
+// appendValues appends the values to the array. A single value is appended as is, while each element of an array of
+// values is appended.
+func appendValues(array, values interface{}) (interface{}, error) {
+	elements, ok := toList(array)
+	if !ok && array != nil {
+		return nil, stacktrace.NewError("%v is not an array", array)
+	}
+	appended := append([]interface{}{}, elements...)
+	if vals, ok := toList(values); ok {
+		return append(appended, vals...), nil
+	}
+	return append(appended, values), nil
+}
+
+// removeValues removes every element of the array equal to the value or to any of the elements of an array of values
+func removeValues(array, values interface{}) (interface{}, error) {
+	if array == nil {
+		return nil, nil
+	}
+	elements, ok := toList(array)
+	if !ok {
+		return nil, stacktrace.NewError("%v is not an array", array)
+	}
+	vals, ok := toList(values)
+	if !ok {
+		vals = []interface{}{values}
+	}
+	remaining := []interface{}{}
+	for _, element := range elements {
+		var remove bool
+		for _, val := range vals {
+			if bytes.Equal(encodeIndexValue(element), encodeIndexValue(val)) {
+				remove = true
+				break
+			}
+		}
+		if !remove {
+			remaining = append(remaining, element)
+		}
+	}
+	return remaining, nil
+}
+
+func toMap(value interface{}) (map[string]interface{}, bool) {
+	if value == nil {
+		return nil, false
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	m := make(map[string]interface{}, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		m[iter.Key().String()] = iter.Value().Interface()
+	}
+	return m, true
+}
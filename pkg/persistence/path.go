@@ -0,0 +1,251 @@
+package persistence
+
+import (
+	"container/heap"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/palantir/stacktrace"
+	"github.com/spf13/cast"
+	"strings"
+)
+
+const defaultPathDepth = 6
+
+// step is the relation that connects a node to the previous node of a path
+type step struct {
+	from     string
+	relation api.Relation
+}
+
+func (d *DB) ShortestPath(opts *api.PathOptions) (*api.Path, error) {
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = defaultPathDepth
+	}
+	from, to, err := d.pathEnds(opts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if from == to {
+		return d.loadPath([]string{from}, nil, 0)
+	}
+	var (
+		// forward & backward map each node that has been reached to the step that reached it
+		forward       = map[string]*step{from: nil}
+		backward      = map[string]*step{to: nil}
+		forwardLevel  = []string{from}
+		backwardLevel = []string{to}
+		meet          string
+	)
+	if err := d.db.View(func(txn *badger.Txn) error {
+		for depth := 0; depth < opts.MaxDepth && meet == ""; depth++ {
+			if len(forwardLevel) == 0 || len(backwardLevel) == 0 {
+				return nil
+			}
+			// expand the smaller frontier
+			var err error
+			if len(forwardLevel) <= len(backwardLevel) {
+				forwardLevel, meet, err = d.expandLevel(txn, forwardLevel, forward, backward, opts.Relations, opts.Direction)
+			} else {
+				var reverse api.Direction
+				if opts.Direction != "" {
+					reverse = opts.Direction.Opposite()
+				}
+				backwardLevel, meet, err = d.expandLevel(txn, backwardLevel, backward, forward, opts.Relations, reverse)
+			}
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if meet == "" {
+		return nil, nil
+	}
+	var (
+		nodes     []string
+		relations []api.Relation
+	)
+	for key := meet; forward[key] != nil; key = forward[key].from {
+		nodes = append([]string{forward[key].from}, nodes...)
+		relations = append([]api.Relation{forward[key].relation}, relations...)
+	}
+	nodes = append(nodes, meet)
+	for key := meet; backward[key] != nil; key = backward[key].from {
+		nodes = append(nodes, backward[key].from)
+		relations = append(relations, backward[key].relation)
+	}
+	return d.loadPath(nodes, relations, float64(len(relations)))
+}
+
+// expandLevel visits the neighbors of every node in the level & returns the next level. If a neighbor has already been
+// reached by the search from the other end, it's returned as the node where the searches meet.
+func (d *DB) expandLevel(txn *badger.Txn, level []string, reached, other map[string]*step, relations []string, direction api.Direction) ([]string, string, error) {
+	var next []string
+	for _, key := range level {
+		nodeType, nodeID := splitNodeKey(key)
+		edges, err := d.edges(txn, nodeType, nodeID, relations, direction, nil, nil)
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "")
+		}
+		for _, e := range edges {
+			neighbor := string(getNodePath(e.neighborType, e.neighborID))
+			if _, ok := reached[neighbor]; ok {
+				continue
+			}
+			reached[neighbor] = &step{from: key, relation: e.relation}
+			if _, ok := other[neighbor]; ok {
+				return nil, neighbor, nil
+			}
+			next = append(next, neighbor)
+		}
+	}
+	return next, "", nil
+}
+
+// label is a path to a node found by WeightedPath
+type label struct {
+	node     string
+	weight   float64
+	depth    int
+	previous *label
+	relation api.Relation
+	index    int
+}
+
+type labelQueue []*label
+
+func (q labelQueue) Len() int { return len(q) }
+
+func (q labelQueue) Less(i, j int) bool { return q[i].weight < q[j].weight }
+
+func (q labelQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *labelQueue) Push(x interface{}) {
+	l := x.(*label)
+	l.index = len(*q)
+	*q = append(*q, l)
+}
+
+func (q *labelQueue) Pop() interface{} {
+	old := *q
+	l := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return l
+}
+
+// WeightedPath finds the path with the lowest total weight using Dijkstra's algorithm. A path to a node is only
+// expanded if it has fewer relations than every cheaper path to the node that was already expanded, so the cheapest
+// path within maxDepth is found even if a cheaper path exceeds it.
+func (d *DB) WeightedPath(opts *api.PathOptions) (*api.Path, error) {
+	if opts.WeightField == "" {
+		return nil, stacktrace.NewError("empty weight field")
+	}
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = defaultPathDepth
+	}
+	from, to, err := d.pathEnds(opts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	var (
+		queue  = &labelQueue{}
+		depths = map[string]int{}
+		found  *label
+	)
+	heap.Push(queue, &label{node: from})
+	if err := d.db.View(func(txn *badger.Txn) error {
+		for queue.Len() > 0 {
+			current := heap.Pop(queue).(*label)
+			if current.node == to {
+				found = current
+				return nil
+			}
+			if depth, ok := depths[current.node]; ok && depth <= current.depth {
+				continue
+			}
+			depths[current.node] = current.depth
+			if current.depth >= opts.MaxDepth {
+				continue
+			}
+			nodeType, nodeID := splitNodeKey(current.node)
+			edges, err := d.edges(txn, nodeType, nodeID, opts.Relations, opts.Direction, nil, nil)
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+			for _, e := range edges {
+				val, err := e.relation.GetProperty(opts.WeightField)
+				if err != nil {
+					return stacktrace.Propagate(err, "")
+				}
+				// relations without a numeric weight are not traversed
+				weight, err := cast.ToFloat64E(val)
+				if val == nil || err != nil {
+					continue
+				}
+				if weight < 0 {
+					return stacktrace.NewError("relation %s %s has a negative weight: %v", e.relation.Type(), e.relation.ID(), weight)
+				}
+				heap.Push(queue, &label{
+					node:     string(getNodePath(e.neighborType, e.neighborID)),
+					weight:   current.weight + weight,
+					depth:    current.depth + 1,
+					previous: current,
+					relation: e.relation,
+				})
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if found == nil {
+		return nil, nil
+	}
+	var (
+		nodes     []string
+		relations []api.Relation
+	)
+	for current := found; current != nil; current = current.previous {
+		nodes = append([]string{current.node}, nodes...)
+		if current.relation != nil {
+			relations = append([]api.Relation{current.relation}, relations...)
+		}
+	}
+	return d.loadPath(nodes, relations, found.weight)
+}
+
+// pathEnds checks that both ends of the path exist & returns their keys
+func (d *DB) pathEnds(opts *api.PathOptions) (string, string, error) {
+	if _, err := d.GetNode(opts.From.Type, opts.From.ID); err != nil {
+		return "", "", stacktrace.Propagate(err, "failed to get from node")
+	}
+	if _, err := d.GetNode(opts.To.Type, opts.To.ID); err != nil {
+		return "", "", stacktrace.Propagate(err, "failed to get to node")
+	}
+	return string(getNodePath(opts.From.Type, opts.From.ID)), string(getNodePath(opts.To.Type, opts.To.ID)), nil
+}
+
+func (d *DB) loadPath(keys []string, relations []api.Relation, weight float64) (*api.Path, error) {
+	path := &api.Path{Relations: relations, Weight: weight}
+	for _, key := range keys {
+		nodeType, nodeID := splitNodeKey(key)
+		n, err := d.GetNode(nodeType, nodeID)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		path.Nodes = append(path.Nodes, n)
+	}
+	return path, nil
+}
+
+// splitNodeKey returns the type & id of a key returned by getNodePath
+func splitNodeKey(key string) (string, string) {
+	split := strings.SplitN(key, ",", 3)
+	return split[1], split[2]
+}
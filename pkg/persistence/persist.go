@@ -9,8 +9,8 @@ import (
 	"github.com/dgraph-io/badger/v3"
 	"github.com/dgraph-io/ristretto"
 	"github.com/palantir/stacktrace"
+	"github.com/spf13/cast"
 	"sort"
-	"strings"
 	"sync"
 )
 
@@ -21,7 +21,12 @@ type DB struct {
 	nodeFieldMap     sync.Map
 	relationTypes    sync.Map
 	relationFieldMap sync.Map
-	cache            *ristretto.Cache
+	nodeRelationMap  sync.Map
+	subscriptions    sync.Map
+	// nodeDefinitions & relationDefinitions hold the *definition of each defined node type & relation
+	nodeDefinitions     sync.Map
+	relationDefinitions sync.Map
+	cache               *ristretto.Cache
 }
 
 func New(dir string) (api.Graph, error) {
@@ -36,6 +41,7 @@ func New(dir string) (api.Graph, error) {
 		nodeFieldMap:     sync.Map{},
 		relationTypes:    sync.Map{},
 		relationFieldMap: sync.Map{},
+		nodeRelationMap:  sync.Map{},
 	}
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1e7,     // number of keys to track frequency of (10M).
@@ -46,7 +52,18 @@ func New(dir string) (api.Graph, error) {
 		return nil, stacktrace.Propagate(err, "failed to create database cache")
 	}
 	d.cache = cache
-
+	if err := d.loadDefinitions(); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if err := d.migrateIndexes(); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if err := d.migrateCounts(); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if err := d.loadCatalog(); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
 	return d, nil
 }
 
@@ -94,161 +111,55 @@ func (d *DB) GetNode(nodeType, nodeID string) (api.Node, error) {
 }
 
 func (d *DB) AddNode(nodeType, nodeID string, properties map[string]interface{}) (api.Node, error) {
-	if properties == nil {
-		properties = map[string]interface{}{}
-	}
-	existing, _ := d.GetNode(nodeType, nodeID)
-	if existing != nil && existing.ID() != "" {
-		if err := d.db.Update(func(txn *badger.Txn) error {
-			for k, v := range properties {
-				key := getNodeTypeFieldPath(nodeType, k, v, nodeID)
-				if err := txn.Delete(key); err != nil {
-					return stacktrace.Propagate(err, "")
-				}
-			}
-			return nil
-		}); err != nil {
-			logger.L.Error("failed to delete existing value index", stacktrace.Propagate(err, ""), map[string]interface{}{})
-		}
-	}
-	d.nodeTypes.Store(nodeType, struct{}{})
-	key := getNodePath(nodeType, nodeID)
-	properties[Internal_ID] = nodeID
-	properties[Internal_Type] = nodeType
-	bits, err := encode.Marshal(properties)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "")
-	}
-
-	if err := d.db.Update(func(txn *badger.Txn) error {
-		if err := txn.Set(key, bits); err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-		for k, v := range properties {
-			d.nodeTypes.Store(strings.Join([]string{nodeType, k}, ","), struct{}{})
-			key := getNodeTypeFieldPath(nodeType, k, v, nodeID)
-			if err := txn.Set(key, bits); err != nil {
-				return stacktrace.Propagate(err, "")
-			}
-		}
-		return nil
+	var n *Node
+	if err := d.update(func(t *tx) error {
+		var err error
+		n, err = d.addNode(t, nodeType, nodeID, properties)
+		return err
 	}); err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
-	n := &Node{
-		nodeType: nodeType,
-		nodeID:   nodeID,
-		data:     properties,
-		db:       d,
-	}
-	d.cache.Set(string(key), n, 1)
 	return n, nil
 }
 
-func (d *DB) DelNode(nodeType, nodeID string) error {
-	key := getNodePath(nodeType, nodeID)
-	if err := d.db.Update(func(txn *badger.Txn) error {
-		return txn.Delete(key)
+// DelNode deletes the node along with its field indexes. If detach is true, every relation connected to the node is
+// deleted with it, otherwise the delete is rejected if the node has any relations.
+func (d *DB) DelNode(nodeType, nodeID string, detach bool) error {
+	if err := d.update(func(t *tx) error {
+		return d.delNode(t, nodeType, nodeID, detach)
 	}); err != nil {
 		return stacktrace.Propagate(err, "")
 	}
-	d.cache.Del(key)
 	return nil
 }
 
+// nodeRelations returns the properties of every relation connected to the node in either direction
+func (d *DB) nodeRelations(txn *badger.Txn, nodeType, nodeID string) (map[string]map[string]interface{}, error) {
+	prefix := getNodeRelationsPrefix(nodeType, nodeID)
+	opt := badger.DefaultIteratorOptions
+	opt.PrefetchSize = prefetchSize
+	it := txn.NewIterator(opt)
+	defer it.Close()
+	relations := map[string]map[string]interface{}{}
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		item := it.Item()
+		props := map[string]interface{}{}
+		if err := item.Value(func(val []byte) error {
+			return encode.Unmarshal(val, &props)
+		}); err != nil {
+			return nil, stacktrace.Propagate(err, "key=%s", string(item.Key()))
+		}
+		relations[cast.ToString(props[Internal_ID])] = props
+	}
+	return relations, nil
+}
+
 func (d *DB) RangeNodes(where *model.NodeWhere) (string, []api.Node, error) {
 	if where.PageSize == nil {
 		pageSize := 25
 		where.PageSize = &pageSize
 	}
-	if len(where.Expressions) > 0 {
-		switch where.Expressions[0].Operator {
-		case model.OperatorEq:
-			return d.rangeEQNodes(where)
-		case model.OperatorHasPrefix:
-			return d.rangeHasPrefixNodes(where)
-		}
-	}
-
-	var (
-		skipped int
-		skip    int
-		err     error
-	)
-	key := getNodePath(where.Type, "")
-	if where.Cursor != nil {
-		skip, err = parseCursor(*where.Cursor)
-		if err != nil {
-			return "", nil, stacktrace.Propagate(err, "")
-		}
-	}
-	evalFunc := func(n api.Node) (bool, error) {
-		passed := true
-		for _, exp := range where.Expressions {
-			passed, err = eval(exp, n)
-			if err != nil {
-				return false, stacktrace.Propagate(err, "")
-			}
-			if !passed {
-				break
-			}
-		}
-		return passed, nil
-	}
-	var nodes []api.Node
-	if err := d.db.View(func(txn *badger.Txn) error {
-		opt := badger.DefaultIteratorOptions
-		opt.PrefetchSize = prefetchSize
-		it := txn.NewIterator(opt)
-		defer it.Close()
-		for it.Seek(key); it.ValidForPrefix(key); it.Next() {
-			if len(nodes) >= *where.PageSize {
-				return nil
-			}
-			if skipped < skip {
-				skipped++
-				continue
-			}
-			item := it.Item()
-			val, ok := d.cache.Get(string(item.Key()))
-			if ok {
-				n := val.(api.Node)
-				passed, err := evalFunc(n)
-				if err != nil {
-					return stacktrace.Propagate(err, "")
-				}
-				if passed {
-					nodes = append(nodes, n)
-				}
-				continue
-			}
-			split := strings.Split(string(item.Key()), ",")
-			nodeID := split[len(split)-1]
-			n := &Node{
-				nodeType: where.Type,
-				nodeID:   nodeID,
-				db:       d,
-			}
-			data := map[string]interface{}{}
-			if err := item.Value(func(val []byte) error {
-				return encode.Unmarshal(val, &data)
-			}); err != nil {
-				return stacktrace.Propagate(err, "")
-			}
-			n.data = data
-			passed, err := evalFunc(n)
-			if err != nil {
-				return err
-			}
-			if passed {
-				nodes = append(nodes, n)
-			}
-		}
-		return nil
-	}); err != nil {
-		return "", nil, err
-	}
-	return createCursor(skip + skipped), nodes, nil
+	return d.rangeNodes(where, nodeRange(where))
 }
 
 func (d *DB) NodeTypes() []string {
@@ -296,70 +207,7 @@ func (d *DB) RangeRelations(where *model.RelationWhere) (string, []api.Relation,
 		pageSize := prefetchSize
 		where.PageSize = &pageSize
 	}
-	var (
-		err     error
-		skipped int
-		skip    int
-		rels    []api.Relation
-	)
-
-	skip, err = parseCursor(*where.Cursor)
-	if err != nil {
-		return "", nil, stacktrace.Propagate(err, "")
-	}
-
-	if err := d.db.View(func(txn *badger.Txn) error {
-
-		key := getRelationPath(where.Relation, "")
-
-		opt := badger.DefaultIteratorOptions
-		opt.PrefetchSize = prefetchSize
-		it := txn.NewIterator(opt)
-
-		defer it.Close()
-		for it.Seek(key); it.ValidForPrefix(key); it.Next() {
-			if len(rels) >= *where.PageSize {
-				return nil
-			}
-			if skipped <= skip {
-				skipped++
-				continue
-			}
-			item := it.Item()
-			split := strings.Split(string(item.Key()), ",")
-			rel := &Relation{
-				relationType: where.Relation,
-				relationID:   split[len(split)-1],
-				db:           d,
-			}
-			data := map[string]interface{}{}
-			if err := item.Value(func(val []byte) error {
-				return encode.Unmarshal(val, &data)
-			}); err != nil {
-				return stacktrace.Propagate(err, "")
-			}
-			rel.item = data
-			if len(rel.item) > 0 {
-				passed := true
-				for _, exp := range where.Expressions {
-					passed, err = eval(exp, rel)
-					if err != nil {
-						return stacktrace.Propagate(err, "")
-					}
-					if !passed {
-						break
-					}
-				}
-				if passed {
-					rels = append(rels, rel)
-				}
-			}
-		}
-		return nil
-	}); err != nil {
-		return "", rels, err
-	}
-	return createCursor(skip + skipped), rels, nil
+	return d.rangeRelations(where, relationRange(where))
 }
 
 func (d *DB) RelationTypes() []string {
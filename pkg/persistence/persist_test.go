@@ -1,9 +1,129 @@
 package persistence
 
 import (
+	"bytes"
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/constants"
+	"github.com/autom8ter/morpheus/pkg/graph/fsm"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/hashicorp/raft"
+	"github.com/palantir/stacktrace"
+	"github.com/spf13/cast"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
 )
 
+type testSink struct {
+	bytes.Buffer
+}
+
+var _ raft.SnapshotSink = &testSink{}
+
+func (t *testSink) ID() string {
+	return "test"
+}
+
+func (t *testSink) Cancel() error {
+	return nil
+}
+
+func (t *testSink) Close() error {
+	return nil
+}
+
+func newTestDB(t *testing.T) *DB {
+	dir, err := ioutil.TempDir("", "badger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	g, err := New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		g.Close()
+	})
+	return g.(*DB)
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	source := newTestDB(t)
+	coleman, err := source.AddNode("user", "colemanword@gmail.com", map[string]interface{}{
+		"name": "Coleman Word",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	choozle, err := source.AddNode("business", "www.choozle.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coleman.AddRelation(api.Outgoing, "works_at", nil, choozle); err != nil {
+		t.Fatal(err)
+	}
+	snapshot, err := source.FSM().Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// writes after the snapshot was taken must not be included in it
+	if _, err := source.AddNode("user", "someone@gmail.com", nil); err != nil {
+		t.Fatal(err)
+	}
+	sink := &testSink{}
+	if err := snapshot.Persist(sink); err != nil {
+		t.Fatal(err)
+	}
+	snapshot.Release()
+
+	dest := newTestDB(t)
+	if _, err := dest.AddNode("user", "stale@gmail.com", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := dest.FSM().Restore(ioutil.NopCloser(&sink.Buffer)); err != nil {
+		t.Fatal(err)
+	}
+	n, err := dest.GetNode("user", "colemanword@gmail.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := n.GetProperty("name"); name != "Coleman Word" {
+		t.Fatalf("expected restored name, got: %v", name)
+	}
+	if _, err := dest.GetNode("user", "someone@gmail.com"); err == nil {
+		t.Fatal("expected write after snapshot to be excluded")
+	}
+	if _, err := dest.GetNode("user", "stale@gmail.com"); err == nil {
+		t.Fatal("expected existing data to be dropped on restore")
+	}
+	_, rels, err := n.Relations(&model.RelationWhere{
+		Direction:  model.DirectionOutgoing,
+		Relation:   "works_at",
+		TargetType: "business",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rels) != 1 {
+		t.Fatalf("expected 1 restored relation, got: %v", len(rels))
+	}
+	if types := dest.NodeTypes(); len(types) != 2 {
+		t.Fatalf("expected 2 restored node types, got: %v", types)
+	}
+}
+
 //
 //func Test(t *testing.T) {
 //	dir, err := ioutil.TempDir("", "badger-test")
@@ -98,3 +218,1384 @@ func jsonString(value interface{}) string {
 	bits, _ := json.MarshalIndent(value, "", "    ")
 	return string(bits)
 }
+
+func TestDelNode(t *testing.T) {
+	g := newTestDB(t)
+	coleman, err := g.AddNode("user", "colemanword@gmail.com", map[string]interface{}{
+		"name": "Coleman Word",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	choozle, err := g.AddNode("business", "www.choozle.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rel, err := coleman.AddRelation(api.Outgoing, "works_at", nil, choozle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.DelNode("user", "colemanword@gmail.com", false); err == nil {
+		t.Fatal("expected delete of node with relations to fail without detach")
+	}
+	if err := g.DelNode("user", "colemanword@gmail.com", true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.GetRelation(rel.Type(), rel.ID()); err == nil {
+		t.Fatal("expected relation to be deleted")
+	}
+	_, rels, err := choozle.Relations(&model.RelationWhere{
+		Direction:  model.DirectionIncoming,
+		Relation:   "works_at",
+		TargetType: "user",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rels) != 0 {
+		t.Fatalf("expected 0 relations, got: %v", len(rels))
+	}
+	_, nodes, err := g.RangeNodes(&model.NodeWhere{
+		Type: "user",
+		Expressions: []*model.Expression{
+			{
+				Key:      "name",
+				Operator: model.OperatorEq,
+				Value:    "Coleman Word",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 0 {
+		t.Fatalf("expected field index to be deleted, got: %v nodes", len(nodes))
+	}
+	if err := g.DelNode("business", "www.choozle.com", false); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSchema(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	g, err := New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	coleman, err := g.AddNode("user", "colemanword@gmail.com", map[string]interface{}{
+		"name": "Coleman Word",
+		"age":  30,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	choozle, err := g.AddNode("business", "www.choozle.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coleman.AddRelation(api.Outgoing, "works_at", map[string]interface{}{
+		"since": 2019,
+	}, choozle); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// the catalog must survive a restart
+	g, err = New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	schema := g.Schema()
+	if len(schema) != 2 {
+		t.Fatalf("expected 2 node types, got: %s", jsonString(schema))
+	}
+	user := schema[1]
+	if user.Type != "user" || len(user.Properties) != 2 || len(user.Relations) != 1 {
+		t.Fatalf("unexpected user schema: %s", jsonString(user))
+	}
+	if user.Properties[0].Name != "age" || user.Properties[0].Kinds[0] != model.ValueKindInt {
+		t.Fatalf("unexpected user properties: %s", jsonString(user.Properties))
+	}
+	rel := user.Relations[0]
+	if rel.Relation != "works_at" || rel.Direction != model.DirectionOutgoing || rel.TargetType != "business" || len(rel.Properties) != 1 {
+		t.Fatalf("unexpected user relations: %s", jsonString(user.Relations))
+	}
+	if business := schema[0]; len(business.Relations) != 1 || business.Relations[0].Direction != model.DirectionIncoming {
+		t.Fatalf("unexpected business schema: %s", jsonString(business))
+	}
+}
+
+func TestRangeNodesIndex(t *testing.T) {
+	g := newTestDB(t)
+	for i := 1990; i <= 2010; i++ {
+		var year interface{} = i
+		if i%2 == 0 {
+			year = float64(i)
+		}
+		if _, err := g.AddNode("movie", fmt.Sprint(i), map[string]interface{}{
+			"year": year,
+			"name": fmt.Sprintf("movie %v", i),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := g.AddNode("movie", "string-year", map[string]interface{}{
+		"year": "2005",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	count := func(op model.Operator, value interface{}) int {
+		pageSize := 3
+		where := &model.NodeWhere{
+			Type:     "movie",
+			PageSize: &pageSize,
+			Expressions: []*model.Expression{
+				{
+					Key:      "year",
+					Operator: op,
+					Value:    value,
+				},
+			},
+		}
+		var total int
+		for {
+			cursor, nodes, err := g.RangeNodes(where)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(nodes) == 0 {
+				return total
+			}
+			total += len(nodes)
+			where.Cursor = &cursor
+		}
+	}
+	for _, test := range []struct {
+		op       model.Operator
+		value    interface{}
+		expected int
+	}{
+		{op: model.OperatorGt, value: 2000, expected: 10},
+		{op: model.OperatorGte, value: 2000.0, expected: 11},
+		{op: model.OperatorLt, value: int64(1995), expected: 5},
+		{op: model.OperatorLte, value: 1995, expected: 6},
+		{op: model.OperatorEq, value: 2005, expected: 1},
+		{op: model.OperatorEq, value: "2005", expected: 1},
+		{op: model.OperatorHasPrefix, value: "20", expected: 1},
+	} {
+		if actual := count(test.op, test.value); actual != test.expected {
+			t.Fatalf("%s %v: expected %v nodes, got: %v", test.op, test.value, test.expected, actual)
+		}
+	}
+}
+
+func TestIndexEncoding(t *testing.T) {
+	g := newTestDB(t)
+	count := func(nodeType string, op model.Operator, value interface{}) int {
+		_, nodes, err := g.RangeNodes(&model.NodeWhere{
+			Type: nodeType,
+			Expressions: []*model.Expression{
+				{
+					Key:      "value",
+					Operator: op,
+					Value:    value,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(nodes)
+	}
+	// times are indexed with the millisecond precision they're stored with
+	at := time.Date(2022, 3, 1, 12, 0, 0, 123456789, time.UTC)
+	if _, err := g.AddNode("event", "1", map[string]interface{}{"value": at}); err != nil {
+		t.Fatal(err)
+	}
+	if actual := count("event", model.OperatorEq, at); actual != 1 {
+		t.Fatalf("expected 1 event, got: %v", actual)
+	}
+	if _, err := g.AddNode("event", "1", map[string]interface{}{"other": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte(nodeFieldsPrefix + ",event,value,")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			t.Fatalf("stale index key: %s", string(it.Item().Key()))
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// -0 equals 0
+	if _, err := g.AddNode("zero", "1", map[string]interface{}{"value": math.Copysign(0, -1)}); err != nil {
+		t.Fatal(err)
+	}
+	if actual := count("zero", model.OperatorEq, 0); actual != 1 {
+		t.Fatalf("expected -0 to equal 0, got: %v", actual)
+	}
+	// integers that round to the same float are distinct
+	for i, value := range []int64{1 << 53, 1<<53 + 1, 1<<62 + 1} {
+		if _, err := g.AddNode("big", fmt.Sprint(i), map[string]interface{}{"value": value}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, test := range []struct {
+		op       model.Operator
+		value    interface{}
+		expected int
+	}{
+		{op: model.OperatorEq, value: int64(1<<53 + 1), expected: 1},
+		{op: model.OperatorGt, value: int64(1 << 53), expected: 2},
+		{op: model.OperatorLt, value: int64(1<<62 + 1), expected: 2},
+		{op: model.OperatorEq, value: float64(1 << 53), expected: 1},
+	} {
+		if actual := count("big", test.op, test.value); actual != test.expected {
+			t.Fatalf("%s %v: expected %v nodes, got: %v", test.op, test.value, test.expected, actual)
+		}
+	}
+	if err := g.DefineNode(&model.TypeDefinition{
+		Type:                 "big",
+		Mode:                 model.SchemaModeStrict,
+		Unique:               [][]string{{"value"}},
+		AdditionalProperties: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRangeNodesFilter(t *testing.T) {
+	g := newTestDB(t)
+	for i := 1990; i <= 2010; i++ {
+		props := map[string]interface{}{
+			"year": i,
+			"name": fmt.Sprintf("movie %v", i),
+		}
+		if i%5 == 0 {
+			props["award"] = true
+		}
+		if _, err := g.AddNode("movie", fmt.Sprint(i), props); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exp := func(key string, op model.Operator, value interface{}) *model.Expression {
+		return &model.Expression{Key: key, Operator: op, Value: value}
+	}
+	count := func(filter *model.Filter) int {
+		pageSize := 2
+		where := &model.NodeWhere{
+			Type:     "movie",
+			PageSize: &pageSize,
+			Filter:   filter,
+		}
+		var total int
+		for {
+			cursor, nodes, err := g.RangeNodes(where)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(nodes) == 0 {
+				return total
+			}
+			total += len(nodes)
+			where.Cursor = &cursor
+		}
+	}
+	for name, test := range map[string]struct {
+		filter   *model.Filter
+		expected int
+	}{
+		"in": {
+			filter:   &model.Filter{Expressions: []*model.Expression{exp("year", model.OperatorIn, []interface{}{1991, 2001.0, 1991, "2002"})}},
+			expected: 2,
+		},
+		"not in": {
+			filter:   &model.Filter{Expressions: []*model.Expression{exp("year", model.OperatorNotIn, []int{1991, 2001})}},
+			expected: 19,
+		},
+		"between": {
+			filter:   &model.Filter{Expressions: []*model.Expression{exp("year", model.OperatorBetween, []interface{}{1995, 2000})}},
+			expected: 6,
+		},
+		"exists": {
+			filter:   &model.Filter{Expressions: []*model.Expression{exp("award", model.OperatorExists, nil)}},
+			expected: 5,
+		},
+		"not exists": {
+			filter:   &model.Filter{Expressions: []*model.Expression{exp("award", model.OperatorNotExists, nil)}},
+			expected: 16,
+		},
+		"regex": {
+			filter:   &model.Filter{Expressions: []*model.Expression{exp("name", model.OperatorRegex, "^movie 199[05]$")}},
+			expected: 2,
+		},
+		"not regex": {
+			filter:   &model.Filter{Not: &model.Filter{Expressions: []*model.Expression{exp("name", model.OperatorRegex, "^movie 199")}}},
+			expected: 11,
+		},
+		"and": {
+			filter: &model.Filter{And: []*model.Filter{
+				{Expressions: []*model.Expression{exp("award", model.OperatorExists, nil)}},
+				{Expressions: []*model.Expression{exp("year", model.OperatorGt, 2000)}},
+			}},
+			expected: 2,
+		},
+		// the branches overlap, so nodes found by the first branch's index range must not be returned again
+		"or": {
+			filter: &model.Filter{Or: []*model.Filter{
+				{Expressions: []*model.Expression{exp("year", model.OperatorLte, 1995)}},
+				{Expressions: []*model.Expression{exp("year", model.OperatorBetween, []interface{}{1993, 1997})}},
+				{Expressions: []*model.Expression{exp("name", model.OperatorEq, "movie 2010")}},
+			}},
+			expected: 9,
+		},
+		"not": {
+			filter: &model.Filter{
+				Expressions: []*model.Expression{exp("year", model.OperatorGte, 2000)},
+				Not:         &model.Filter{Expressions: []*model.Expression{exp("award", model.OperatorExists, nil)}},
+			},
+			expected: 8,
+		},
+	} {
+		if actual := count(test.filter); actual != test.expected {
+			t.Fatalf("%s: expected %v nodes, got: %v", name, test.expected, actual)
+		}
+	}
+	// invalid patterns are rejected before any node is read, even if no node would reach the expression
+	invalid := &model.Filter{Or: []*model.Filter{{Expressions: []*model.Expression{exp("name", model.OperatorRegex, "(")}}}}
+	if _, _, err := g.RangeNodes(&model.NodeWhere{Type: "show", Filter: invalid}); err == nil {
+		t.Fatal("expected an invalid regex to fail")
+	}
+	if _, err := g.CountNodes(&model.NodeWhere{Type: "show", Filter: invalid}); err == nil {
+		t.Fatal("expected an invalid regex to fail")
+	}
+	if _, err := g.SubscribeNodes(context.Background(), "show", invalid.Or[0].Expressions); err == nil {
+		t.Fatal("expected an invalid regex to fail")
+	}
+	plan := planIndex(func(field string) []byte {
+		return getNodeTypeFieldPrefix("movie", field)
+	}, []*model.Expression{exp("year", model.OperatorGt, 2000)}, &model.Filter{
+		And: []*model.Filter{{Expressions: []*model.Expression{exp("name", model.OperatorEq, "movie 2005")}}},
+	})
+	if plan == nil || plan.rank != rankEq || plan.ranges[0].field != "name" {
+		t.Fatalf("expected the equality expression to be answered by the name index")
+	}
+}
+
+func TestRangeNodesNested(t *testing.T) {
+	g := newTestDB(t)
+	for id, props := range map[string]map[string]interface{}{
+		"1": {"address": map[string]interface{}{"city": "denver"}, "tags": []string{"a", "b", "x", "y"}},
+		"2": {"address": map[string]interface{}{"city": "boston"}, "tags": []interface{}{"b", "c"}},
+		"3": {"address": map[string]interface{}{"city": "denver"}, "tags": "c"},
+		"4": {"orders": []interface{}{map[string]interface{}{"sku": "s1"}, map[string]interface{}{"sku": "s2"}}},
+	} {
+		if _, err := g.AddNode("user", id, props); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exp := func(key string, op model.Operator, value interface{}) *model.Expression {
+		return &model.Expression{Key: key, Operator: op, Value: value}
+	}
+	count := func(expressions ...*model.Expression) int {
+		pageSize := 1
+		where := &model.NodeWhere{
+			Type:        "user",
+			PageSize:    &pageSize,
+			Expressions: expressions,
+		}
+		var total int
+		for {
+			cursor, nodes, err := g.RangeNodes(where)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(nodes) == 0 {
+				return total
+			}
+			total += len(nodes)
+			where.Cursor = &cursor
+		}
+	}
+	for name, test := range map[string]struct {
+		expression *model.Expression
+		expected   int
+	}{
+		"nested eq":    {expression: exp("address.city", model.OperatorEq, "denver"), expected: 2},
+		"array index":  {expression: exp("tags.1", model.OperatorEq, "c"), expected: 1},
+		"contains any": {expression: exp("tags", model.OperatorContainsAny, []string{"a", "c", "x"}), expected: 3},
+		"contains all": {expression: exp("tags", model.OperatorContainsAll, []string{"b", "a"}), expected: 1},
+		"scalar gt":    {expression: exp("tags", model.OperatorGt, "a"), expected: 1},
+		"array of maps": {
+			expression: exp("orders.sku", model.OperatorContainsAny, []string{"s2"}),
+			expected:   1,
+		},
+	} {
+		if actual := count(test.expression); actual != test.expected {
+			t.Fatalf("%s: expected %v nodes, got: %v", name, test.expected, actual)
+		}
+	}
+	// every element of an array is indexed, so a node must only be returned once even if several elements match
+	if ranges := nodeRange(&model.NodeWhere{Type: "user", Expressions: []*model.Expression{exp("tags", model.OperatorContainsAny, []string{"b", "x", "y"})}}); len(ranges) != 3 || ranges[0].field != "tags" {
+		t.Fatal("expected an index range per value")
+	}
+	if actual := count(exp("tags", model.OperatorContainsAny, []string{"b", "x", "y"})); actual != 2 {
+		t.Fatalf("expected 2 nodes, got: %v", actual)
+	}
+	if err := g.DelNode("user", "1", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(getNodeTypeFieldPath("user", "tags", "x", "1"))
+		return err
+	}); err != badger.ErrKeyNotFound {
+		t.Fatalf("expected the array element index to be deleted, got: %v", err)
+	}
+}
+
+func TestRangeNodesOrderBy(t *testing.T) {
+	g := newTestDB(t)
+	for id, props := range map[string]map[string]interface{}{
+		"a": {"year": 2001, "scores": []int{5, 1}, "genre": "drama"},
+		"b": {"year": 1999.0, "scores": []int{3}, "genre": "comedy"},
+		"c": {"genre": "drama"},
+		"d": {"year": nil, "scores": []int{4, 9}, "genre": "drama"},
+		"e": {"year": 2001, "genre": "comedy"},
+		"f": {"year": []int{1995, 2010}, "scores": []int{1, 2}, "genre": "drama"},
+	} {
+		if _, err := g.AddNode("movie", id, props); err != nil {
+			t.Fatal(err)
+		}
+	}
+	list := func(field string, reverse bool, expressions ...*model.Expression) string {
+		pageSize := 2
+		where := &model.NodeWhere{
+			Type:        "movie",
+			PageSize:    &pageSize,
+			Expressions: expressions,
+			OrderBy:     &model.OrderBy{Field: field, Reverse: &reverse},
+		}
+		var ids []string
+		for {
+			cursor, nodes, err := g.RangeNodes(where)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(nodes) == 0 {
+				return strings.Join(ids, ",")
+			}
+			for _, n := range nodes {
+				ids = append(ids, n.ID())
+			}
+			where.Cursor = &cursor
+		}
+	}
+	drama := &model.Expression{Key: "genre", Operator: model.OperatorEq, Value: "drama"}
+	for name, test := range map[string]struct {
+		actual   string
+		expected string
+	}{
+		// arrays are ordered by their first element in the sort order & entities without a value are last
+		"index":               {actual: list("year", false), expected: "f,b,a,e,c,d"},
+		"index reverse":       {actual: list("year", true), expected: "f,e,a,b,c,d"},
+		"index filtered":      {actual: list("year", false, drama), expected: "f,a,c,d"},
+		"index range":         {actual: list("year", true, &model.Expression{Key: "year", Operator: model.OperatorLt, Value: 2005}), expected: "e,a,b"},
+		"bounded sort":        {actual: list("scores.0", false), expected: "f,b,d,a,c,e"},
+		"bounded sort filter": {actual: list("scores.0", true, drama), expected: "a,d,f,c"},
+	} {
+		if test.actual != test.expected {
+			t.Fatalf("%s: expected %s got %s", name, test.expected, test.actual)
+		}
+	}
+	a, err := g.GetNode("movie", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, weight := range map[string]int{"b": 3, "c": 1, "d": 4, "e": 2} {
+		target, err := g.GetNode("movie", id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := a.AddRelation(api.Outgoing, "similar", map[string]interface{}{"weight": weight}, target); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pageSize := 3
+	where := &model.RelationWhere{
+		Direction:  model.DirectionOutgoing,
+		Relation:   "similar",
+		TargetType: "movie",
+		PageSize:   &pageSize,
+		OrderBy:    &model.OrderBy{Field: "weight"},
+	}
+	var weights []string
+	for {
+		cursor, rels, err := a.Relations(where)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rels) == 0 {
+			break
+		}
+		for _, rel := range rels {
+			weight, _ := rel.GetProperty("weight")
+			weights = append(weights, fmt.Sprint(weight))
+		}
+		where.Cursor = &cursor
+	}
+	if actual := strings.Join(weights, ","); actual != "1,2,3,4" {
+		t.Fatalf("expected relations ordered by weight, got: %s", actual)
+	}
+}
+
+func TestCursors(t *testing.T) {
+	g := newTestDB(t)
+	for i := 0; i < 10; i++ {
+		if _, err := g.AddNode("user", fmt.Sprint(i*2), map[string]interface{}{"age": i * 2, "ages": []int{i * 2}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pageSize := 3
+	ids := func(nodes []api.Node) string {
+		var ids []string
+		for _, n := range nodes {
+			ids = append(ids, n.ID())
+		}
+		return strings.Join(ids, ",")
+	}
+	for name, orderBy := range map[string]*model.OrderBy{
+		"seek": {Field: "age"},
+		"sort": {Field: "ages.0"},
+	} {
+		where := &model.NodeWhere{Type: "user", PageSize: &pageSize, OrderBy: orderBy}
+		cursor, nodes, err := g.RangeNodes(where)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual := ids(nodes); actual != "0,2,4" {
+			t.Fatalf("%s: unexpected first page: %s", name, actual)
+		}
+		// nodes added before the cursor don't shift the next page
+		if _, err := g.AddNode("user", fmt.Sprintf("%s-1", name), map[string]interface{}{"age": 1, "ages": []int{1}}); err != nil {
+			t.Fatal(err)
+		}
+		where.Cursor = &cursor
+		_, nodes, err = g.RangeNodes(where)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual := ids(nodes); actual != "6,8,10" {
+			t.Fatalf("%s: unexpected second page: %s", name, actual)
+		}
+		if err := g.DelNode("user", fmt.Sprintf("%s-1", name), true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// deprecated offset cursors skip the given number of keys
+	offset := base64.StdEncoding.EncodeToString([]byte("cursor-2"))
+	_, nodes, err := g.RangeNodes(&model.NodeWhere{Type: "user", PageSize: &pageSize, Cursor: &offset, OrderBy: &model.OrderBy{Field: "age"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual := ids(nodes); actual != "4,6,8" {
+		t.Fatalf("unexpected offset page: %s", actual)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	g := newTestDB(t)
+	movies := []map[string]interface{}{
+		{"genre": "drama", "year": 1994, "rating": 9.3, "director": "darabont"},
+		{"genre": "drama", "year": 1999, "rating": 8.8, "director": "fincher"},
+		{"genre": "drama", "year": 2008, "rating": 8.1, "director": "fincher"},
+		{"genre": "action", "year": 2008, "rating": 9.0, "director": "nolan"},
+		{"genre": "action", "year": 2010, "rating": 8.8, "director": "nolan"},
+		{"genre": "comedy", "year": 1980, "rating": 5.0, "director": "landis"},
+	}
+	for i, movie := range movies {
+		if _, err := g.AddNode("movie", fmt.Sprint(i), movie); err != nil {
+			t.Fatal(err)
+		}
+	}
+	field := func(name string) *string {
+		return &name
+	}
+	decade := float64(10)
+	rows, err := g.Aggregate(&api.AggregateOptions{
+		Type: "movie",
+		Filter: &model.Filter{Expressions: []*model.Expression{
+			{Key: "year", Operator: model.OperatorGte, Value: 1990},
+		}},
+		GroupBy: []string{"genre"},
+		Metrics: []*model.Metric{
+			{Fn: model.AggregateFunctionCount},
+			{Fn: model.AggregateFunctionCountDistinct, Field: field("director")},
+			{Fn: model.AggregateFunctionPercentile, Field: field("rating")},
+			{Fn: model.AggregateFunctionHistogram, Field: field("year"), BucketSize: &decade},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(rows))
+	}
+	var actual []string
+	for _, row := range rows {
+		var buckets []string
+		for _, bucket := range row.Metrics[3].Buckets {
+			buckets = append(buckets, fmt.Sprintf("%v:%v", bucket.Start, bucket.Count))
+		}
+		actual = append(actual, fmt.Sprintf("%v count=%v directors=%v median=%.2f decades=%s",
+			row.Group["genre"],
+			*row.Metrics[0].Value,
+			*row.Metrics[1].Value,
+			*row.Metrics[2].Value,
+			strings.Join(buckets, ","),
+		))
+	}
+	expected := []string{
+		"action count=2 directors=1 median=8.90 decades=2000:1,2010:1",
+		"drama count=3 directors=2 median=8.80 decades=1990:2,2000:1",
+	}
+	if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected aggregate rows: %v", actual)
+	}
+	// aggregating without grouping returns a single row, even if nothing matches
+	rows, err = g.Aggregate(&api.AggregateOptions{
+		Type: "movie",
+		Filter: &model.Filter{Expressions: []*model.Expression{
+			{Key: "genre", Operator: model.OperatorEq, Value: "horror"},
+		}},
+		Metrics: []*model.Metric{{Fn: model.AggregateFunctionCount}, {Fn: model.AggregateFunctionAvg, Field: field("rating")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || *rows[0].Metrics[0].Value != 0 || rows[0].Metrics[1].Value != nil {
+		t.Fatalf("unexpected empty aggregate: %v", rows)
+	}
+	if _, err := g.Aggregate(&api.AggregateOptions{
+		Type:    "movie",
+		Metrics: []*model.Metric{{Fn: model.AggregateFunctionHistogram, Field: field("year")}},
+	}); err == nil {
+		t.Fatal("expected histogram without a bucket size to fail")
+	}
+}
+
+func TestCount(t *testing.T) {
+	g := newTestDB(t)
+	for i := 0; i < 10; i++ {
+		if _, err := g.AddNode("actor", fmt.Sprint(i), map[string]interface{}{
+			"age":  20 + i,
+			"tags": []string{"actor", fmt.Sprintf("tag%v", i%2)},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// writing an existing node doesn't change the count
+	if _, err := g.AddNode("actor", "0", map[string]interface{}{"age": 20}); err != nil {
+		t.Fatal(err)
+	}
+	movie, err := g.AddNode("movie", "heat", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 4; i++ {
+		actor, err := g.GetNode("actor", fmt.Sprint(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := actor.AddRelation(api.Outgoing, "acted_in", map[string]interface{}{"year": 1990 + i}, movie); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := g.DelNode("actor", "3", true); err != nil {
+		t.Fatal(err)
+	}
+	for name, test := range map[string]struct {
+		where    *model.NodeWhere
+		expected int
+	}{
+		"all":      {where: &model.NodeWhere{Type: "actor"}, expected: 9},
+		"missing":  {where: &model.NodeWhere{Type: "director"}, expected: 0},
+		"exact":    {where: &model.NodeWhere{Type: "actor", Expressions: []*model.Expression{{Key: "age", Operator: model.OperatorGte, Value: 25}}}, expected: 5},
+		"array":    {where: &model.NodeWhere{Type: "actor", Expressions: []*model.Expression{{Key: "tags", Operator: model.OperatorContainsAny, Value: []interface{}{"actor", "tag1"}}}}, expected: 8},
+		"scan":     {where: &model.NodeWhere{Type: "actor", Expressions: []*model.Expression{{Key: "age", Operator: model.OperatorNeq, Value: 21}}}, expected: 8},
+		"combined": {where: &model.NodeWhere{Type: "actor", Filter: &model.Filter{Or: []*model.Filter{{Expressions: []*model.Expression{{Key: "age", Operator: model.OperatorLt, Value: 22}}}, {Expressions: []*model.Expression{{Key: "age", Operator: model.OperatorGt, Value: 27}}}}}}, expected: 4},
+	} {
+		count, err := g.CountNodes(test.where)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != test.expected {
+			t.Fatalf("%s: expected %v nodes, got %v", name, test.expected, count)
+		}
+	}
+	count, err := g.CountRelations(&model.RelationWhere{Relation: "acted_in"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Fatalf("expected 3 relations, got %v", count)
+	}
+	count, err = movie.CountRelations(&model.RelationWhere{
+		Direction:   model.DirectionIncoming,
+		Relation:    "acted_in",
+		TargetType:  "actor",
+		Expressions: []*model.Expression{{Key: "year", Operator: model.OperatorGt, Value: 1990}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 relations of the movie, got %v", count)
+	}
+	// counters are backfilled if they don't exist
+	if err := g.db.DropPrefix(getMetaPath("")); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.migrateCounts(); err != nil {
+		t.Fatal(err)
+	}
+	count, err = g.CountNodes(&model.NodeWhere{Type: "actor"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 9 {
+		t.Fatalf("expected 9 backfilled nodes, got %v", count)
+	}
+}
+
+func TestTransaction(t *testing.T) {
+	g := newTestDB(t)
+	id := func(id string) *string {
+		return &id
+	}
+	results, err := g.Transaction([]*model.Op{
+		{AddNode: &model.AddNode{Type: "user", ID: id("1"), Properties: map[string]interface{}{"name": "coleman"}}},
+		{AddNode: &model.AddNode{Type: "business", ID: id("1"), Properties: map[string]interface{}{"name": "choozle"}}},
+		{AddRelation: &model.AddRelation{Source: &model.Key{Type: "user", ID: "1"}, Relation: "works_at", Target: &model.Key{Type: "business", ID: "1"}}},
+		{SetProperties: &model.SetProperties{Node: &model.Key{Type: "user", ID: "1"}, Properties: map[string]interface{}{"name": "coleman word"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %v", len(results))
+	}
+	rel, ok := results[2].(api.Relation)
+	if !ok {
+		t.Fatalf("expected a relation, got %T", results[2])
+	}
+	if _, err := g.GetRelation("works_at", rel.ID()); err != nil {
+		t.Fatal(err)
+	}
+	user, err := g.GetNode("user", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := user.GetProperty("name"); name != "coleman word" {
+		t.Fatalf("unexpected name: %v", name)
+	}
+	// the last operation fails, so none of them are applied
+	_, err = g.Transaction([]*model.Op{
+		{AddNode: &model.AddNode{Type: "user", ID: id("2"), Properties: map[string]interface{}{"name": "tyler"}}},
+		{DelRelation: &model.Key{Type: "works_at", ID: rel.ID()}},
+		{AddRelation: &model.AddRelation{Source: &model.Key{Type: "user", ID: "2"}, Relation: "works_at", Target: &model.Key{Type: "business", ID: "2"}}},
+	})
+	if err == nil {
+		t.Fatal("expected a relation to a missing node to fail")
+	}
+	if _, err := g.GetNode("user", "2"); err == nil {
+		t.Fatal("expected node of failed transaction not to exist")
+	}
+	if _, err := g.GetRelation("works_at", rel.ID()); err != nil {
+		t.Fatal("expected relation deleted by failed transaction to exist")
+	}
+	count, err := g.CountNodes(&model.NodeWhere{Type: "user", Expressions: []*model.Expression{{Key: "name", Operator: model.OperatorEq, Value: "tyler"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Fatalf("expected no index keys of failed transaction, got %v", count)
+	}
+	if _, err := g.Transaction([]*model.Op{{}}); err == nil {
+		t.Fatal("expected empty operation to fail")
+	}
+}
+
+func TestPatch(t *testing.T) {
+	g := newTestDB(t)
+	user, err := g.AddNode("user", "1", map[string]interface{}{
+		"name":    "coleman",
+		"logins":  1,
+		"tags":    []interface{}{"a", "b"},
+		"address": map[string]interface{}{"city": "denver", "zip": "80202"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := user.PatchProperties(&model.Patch{
+		Set:       map[string]interface{}{"name": nil, "email": "coleman@example.com", "address": map[string]interface{}{"zip": nil, "state": "co"}},
+		Increment: map[string]interface{}{"logins": 2, "score": 1.5},
+		Append:    map[string]interface{}{"tags": "c"},
+		Remove:    map[string]interface{}{"tags": []interface{}{"a"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	user, err = g.GetNode("user", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	props, err := user.Properties()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := props["name"]; ok {
+		t.Fatal("expected name to be deleted")
+	}
+	if props["email"] != "coleman@example.com" || cast.ToInt(props["logins"]) != 3 || cast.ToFloat64(props["score"]) != 1.5 {
+		t.Fatalf("unexpected properties: %v", props)
+	}
+	if tags := cast.ToStringSlice(props["tags"]); len(tags) != 2 || tags[0] != "b" || tags[1] != "c" {
+		t.Fatalf("unexpected tags: %v", props["tags"])
+	}
+	address := cast.ToStringMap(props["address"])
+	if address["city"] != "denver" || address["state"] != "co" || address["zip"] != nil {
+		t.Fatalf("unexpected address: %v", address)
+	}
+	// index keys of removed values don't survive the patch
+	var indexes []string
+	if err := g.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte(nodeFieldsPrefix + ",user,")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			indexes = append(indexes, string(it.Item().KeyCopy(nil)))
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := getNodeTypeFieldPaths("user", props, "1"); len(indexes) != len(expected) {
+		t.Fatalf("expected %v index keys, got %v", len(expected), indexes)
+	}
+	if err := user.PatchProperties(&model.Patch{Increment: map[string]interface{}{"email": 1}}); err == nil {
+		t.Fatal("expected incrementing a string to fail")
+	}
+	// replacing the properties removes every property that isn't replaced
+	if err := user.SetProperties(map[string]interface{}{"name": "coleman word"}); err != nil {
+		t.Fatal(err)
+	}
+	_, nodes, err := g.RangeNodes(&model.NodeWhere{Type: "user", Expressions: []*model.Expression{{Key: "email", Operator: model.OperatorEq, Value: "coleman@example.com"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 0 {
+		t.Fatalf("expected no nodes matching replaced email, got %v", len(nodes))
+	}
+	business, err := g.AddNode("business", "1", map[string]interface{}{"name": "choozle"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rel, err := user.AddRelation(api.Outgoing, "works_at", map[string]interface{}{"title": "engineer"}, business)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rel.PatchProperties(&model.Patch{Set: map[string]interface{}{"since": 2020}}); err != nil {
+		t.Fatal(err)
+	}
+	rel, err = g.GetRelation("works_at", rel.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if title, _ := rel.GetProperty("title"); title != "engineer" {
+		t.Fatalf("unexpected title: %v", title)
+	}
+	if source, err := rel.Source(); err != nil || source.ID() != "1" {
+		t.Fatalf("unexpected source: %v %v", source, err)
+	}
+}
+
+func TestDefinitions(t *testing.T) {
+	g := newTestDB(t)
+	str, integer := model.ValueKindString, model.ValueKindInt
+	email := `^[^@]+@[^@]+$`
+	user := &model.TypeDefinition{
+		Type: "user",
+		Mode: model.SchemaModeStrict,
+		Properties: []*model.PropertyDefinition{
+			{Name: "first_name", Kind: &str, Required: true},
+			{Name: "age", Kind: &integer},
+			{Name: "status", Default: "active", Enum: []interface{}{"active", "inactive"}},
+			{Name: "email", Regex: &email},
+		},
+	}
+	if err := g.DefineNode(user); err != nil {
+		t.Fatal(err)
+	}
+	_, err := g.AddNode("user", "1", map[string]interface{}{"frist_name": "coleman", "age": "30", "email": "coleman"})
+	validation, ok := stacktrace.RootCause(err).(*constants.ValidationError)
+	if !ok {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	for _, field := range []string{"frist_name", "first_name", "age", "email"} {
+		if _, ok := validation.Violations[field]; !ok {
+			t.Fatalf("expected a violation of %s, got %v", field, validation.Violations)
+		}
+	}
+	n, err := g.AddNode("user", "1", map[string]interface{}{"first_name": "coleman", "age": 30, "email": "coleman@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status, _ := n.GetProperty("status"); status != "active" {
+		t.Fatalf("expected default status, got %v", status)
+	}
+	if err := n.PatchProperties(&model.Patch{Set: map[string]interface{}{"status": "deleted"}}); err == nil {
+		t.Fatal("expected status outside of the enum to fail")
+	}
+	if err := g.DefineRelation(&model.TypeDefinition{
+		Type:                 "acted_in",
+		Mode:                 model.SchemaModeStrict,
+		Endpoints:            []*model.Endpoint{{Source: "actor", Target: "movie"}},
+		AdditionalProperties: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	actor, err := g.AddNode("actor", "1", map[string]interface{}{"name": "keanu reeves"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	movie, err := g.AddNode("movie", "1", map[string]interface{}{"title": "the matrix"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := actor.AddRelation(api.Outgoing, "acted_in", map[string]interface{}{"role": "neo"}, movie); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := movie.AddRelation(api.Outgoing, "acted_in", nil, actor); err == nil {
+		t.Fatal("expected relation from a movie to an actor to fail")
+	}
+	// violations of definitions in warn mode are only logged
+	user.Mode = model.SchemaModeWarn
+	if err := g.DefineNode(user); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.AddNode("user", "2", map[string]interface{}{"frist_name": "tyler"}); err != nil {
+		t.Fatal(err)
+	}
+	badRegex := "("
+	if err := g.DefineNode(&model.TypeDefinition{Type: "bad", Mode: model.SchemaModeStrict, Properties: []*model.PropertyDefinition{{Name: "name", Regex: &badRegex}}}); err == nil {
+		t.Fatal("expected invalid regex to fail")
+	}
+	if err := g.loadDefinitions(); err != nil {
+		t.Fatal(err)
+	}
+	defs := g.NodeDefinitions()
+	if len(defs) != 1 || defs[0].Type != "user" || defs[0].Mode != model.SchemaModeWarn || len(defs[0].Properties) != 4 {
+		t.Fatalf("unexpected definitions: %v", defs)
+	}
+	if err := g.DropNodeDefinition("user"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.AddNode("user", "3", map[string]interface{}{"anything": true}); err != nil {
+		t.Fatal(err)
+	}
+	if len(g.RelationDefinitions()) != 1 {
+		t.Fatalf("expected a relation definition, got %v", g.RelationDefinitions())
+	}
+}
+
+func TestUnique(t *testing.T) {
+	g := newTestDB(t)
+	id := func(id string) *string {
+		return &id
+	}
+	for _, nodeID := range []string{"1", "2"} {
+		if _, err := g.AddNode("user", nodeID, map[string]interface{}{"email": "coleman@example.com"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	user := &model.TypeDefinition{
+		Type:                 "user",
+		Mode:                 model.SchemaModeWarn,
+		Unique:               [][]string{{"email"}, {"last_name", "first_name"}},
+		AdditionalProperties: true,
+	}
+	if err := g.DefineNode(user); stacktrace.GetCode(err) != http.StatusConflict {
+		t.Fatalf("expected existing duplicates to conflict, got %v", err)
+	}
+	if err := g.DelNode("user", "2", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.DefineNode(user); err != nil {
+		t.Fatal(err)
+	}
+	_, err := g.AddNode("user", "2", map[string]interface{}{"email": "coleman@example.com"})
+	if stacktrace.GetCode(err) != http.StatusConflict {
+		t.Fatalf("expected duplicate email to conflict, got %v", err)
+	}
+	if _, ok := stacktrace.RootCause(err).(*constants.ValidationError); !ok {
+		t.Fatalf("expected a validation error, got %T", stacktrace.RootCause(err))
+	}
+	if _, err := g.AddNode("user", "2", map[string]interface{}{"first_name": "coleman", "last_name": "word"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.AddNode("user", "3", map[string]interface{}{"first_name": "coleman", "last_name": "word"}); err == nil {
+		t.Fatal("expected duplicate name to conflict")
+	}
+	if _, err := g.AddNode("user", "3", map[string]interface{}{"first_name": "coleman"}); err != nil {
+		t.Fatalf("expected partial name not to conflict: %v", err)
+	}
+	// changing the email of a node frees its previous email
+	if _, err := g.AddNode("user", "1", map[string]interface{}{"email": "coleman.word@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Transaction([]*model.Op{
+		{AddNode: &model.AddNode{Type: "user", ID: id("4"), Properties: map[string]interface{}{"email": "coleman@example.com"}}},
+		{AddNode: &model.AddNode{Type: "user", ID: id("5"), Properties: map[string]interface{}{"email": "coleman@example.com"}}},
+	}); err == nil {
+		t.Fatal("expected duplicate emails within a transaction to conflict")
+	}
+	if _, err := g.GetNode("user", "4"); err == nil {
+		t.Fatal("expected node of failed transaction not to exist")
+	}
+	n, err := g.Upsert(&model.Upsert{Type: "user", ID: id("6"), Match: map[string]interface{}{"email": "coleman.word@example.com"}, Properties: map[string]interface{}{"name": "coleman"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.ID() != "1" {
+		t.Fatalf("expected upsert to find node 1, got %v", n.ID())
+	}
+	if name, _ := n.GetProperty("name"); name != "coleman" {
+		t.Fatalf("unexpected name: %v", name)
+	}
+	n, err = g.Upsert(&model.Upsert{Type: "user", ID: id("6"), Match: map[string]interface{}{"email": "tyler@example.com"}, Properties: map[string]interface{}{"name": "tyler"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if email, _ := n.GetProperty("email"); n.ID() != "6" || email != "tyler@example.com" {
+		t.Fatalf("expected upsert to add node 6, got %v %v", n.ID(), email)
+	}
+	if _, err := g.Upsert(&model.Upsert{Type: "user", ID: id("7"), Match: map[string]interface{}{"name": "tyler"}}); err == nil {
+		t.Fatal("expected upsert without a unique constraint to fail")
+	}
+	// deleting a node frees its email
+	if err := g.DelNode("user", "6", true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.AddNode("user", "7", map[string]interface{}{"email": "tyler@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.DropNodeDefinition("user"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.AddNode("user", "8", map[string]interface{}{"email": "tyler@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPatchReplay(t *testing.T) {
+	g := newTestDB(t)
+	if _, err := g.apply(1, fsm.CMD{Method: fsm.MethodSet, Node: model.Node{Type: "user", ID: "1", Properties: map[string]interface{}{"logins": 1, "tags": []interface{}{"a"}}}}); err != nil {
+		t.Fatal(err)
+	}
+	patch := fsm.CMD{
+		Method:   fsm.MethodNodePatchProperties,
+		Patch:    &model.Patch{Increment: map[string]interface{}{"logins": 1}, Append: map[string]interface{}{"tags": "b"}},
+		Metadata: map[string]string{"type": "user", "id": "1"},
+	}
+	// replaying a patch after a restart doesn't increment or append twice
+	for _, index := range []uint64{2, 2, 1} {
+		if _, err := g.apply(index, patch); err != nil {
+			t.Fatal(err)
+		}
+	}
+	n, err := g.GetNode("user", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	props, err := n.Properties()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cast.ToInt(props["logins"]) != 2 || len(cast.ToSlice(props["tags"])) != 2 {
+		t.Fatalf("expected the patch to be applied once, got: %v", props)
+	}
+}
+
+func TestVersions(t *testing.T) {
+	g := newTestDB(t)
+	apply := func(index uint64, cmd fsm.CMD) (interface{}, error) {
+		return g.apply(index, cmd)
+	}
+	version := func(ifVersion int) map[string]string {
+		return map[string]string{"if_version": fmt.Sprint(ifVersion)}
+	}
+	user := model.Node{Type: "user", ID: "1", Properties: map[string]interface{}{"name": "coleman"}}
+	// a precondition of version 0 only creates the node if it doesn't exist
+	result, err := apply(5, fsm.CMD{Method: fsm.MethodSet, Node: user, Metadata: version(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := result.(api.Node).GetProperty(Internal_Version); cast.ToInt64(v) != 5 {
+		t.Fatalf("expected version of the log index, got %v", v)
+	}
+	// replaying the log entry after a restart leaves the version unchanged
+	if result, err := apply(5, fsm.CMD{Method: fsm.MethodSet, Node: user}); err != nil || result != nil {
+		t.Fatalf("expected the replayed entry to be skipped, got: %v %v", result, err)
+	}
+	n, err := g.GetNode("user", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := n.GetProperty(Internal_Version); cast.ToInt64(v) != 5 {
+		t.Fatalf("expected version 5 after replay, got %v", v)
+	}
+	if _, err := apply(6, fsm.CMD{Method: fsm.MethodSet, Node: user, Metadata: version(0)}); stacktrace.GetCode(err) != stacktrace.GetCode(constants.ErrVersionConflict) {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+	if _, err := apply(7, fsm.CMD{
+		Method:     fsm.MethodNodeSetProperties,
+		Properties: map[string]interface{}{"name": "coleman word"},
+		Metadata:   map[string]string{"type": "user", "id": "1", "if_version": "5"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	n, err = g.GetNode("user", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := n.GetProperty(Internal_Version); cast.ToInt64(v) != 7 {
+		t.Fatalf("expected version 7, got %v", v)
+	}
+	// writes outside of raft still increase the version
+	if _, err := g.AddNode("user", "1", map[string]interface{}{"name": "coleman"}); err != nil {
+		t.Fatal(err)
+	}
+	n, err = g.GetNode("user", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := n.GetProperty(Internal_Version); cast.ToInt64(v) != 8 {
+		t.Fatalf("expected version 8, got %v", v)
+	}
+	if _, err := apply(9, fsm.CMD{Method: fsm.MethodDel, Key: model.Key{Type: "user", ID: "1"}, Metadata: version(7)}); stacktrace.GetCode(err) != stacktrace.GetCode(constants.ErrVersionConflict) {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+	if _, err := apply(10, fsm.CMD{Method: fsm.MethodDel, Key: model.Key{Type: "user", ID: "1"}, Metadata: version(8)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.GetNode("user", "1"); err == nil {
+		t.Fatal("expected node to be deleted")
+	}
+}
+
+func TestSubscribeNodes(t *testing.T) {
+	g := newTestDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes, err := g.SubscribeNodes(ctx, "user", []*model.Expression{
+		{
+			Key:      "name",
+			Operator: model.OperatorEq,
+			Value:    "Coleman Word",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.AddNode("user", "someone@gmail.com", map[string]interface{}{
+		"name": "Someone Else",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.AddNode("user", "colemanword@gmail.com", map[string]interface{}{
+		"name": "Coleman Word",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.DelNode("user", "colemanword@gmail.com", true); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []model.ChangeType{model.ChangeTypeSet, model.ChangeTypeDelete} {
+		change := <-changes
+		if change.Change != expected || change.ID != "colemanword@gmail.com" {
+			t.Fatalf("unexpected change: %v %v", change.Change, change.ID)
+		}
+	}
+	cancel()
+	for range changes {
+		t.Fatal("expected no more changes")
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	g := newTestDB(t)
+	var users []api.Node
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		n, err := g.AddNode("user", name, map[string]interface{}{
+			"name": name,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		users = append(users, n)
+	}
+	// a -> b -> c -> d, a <- e
+	for i := 0; i < 3; i++ {
+		if _, err := users[i].AddRelation(api.Outgoing, "follows", map[string]interface{}{"weight": i}, users[i+1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := users[0].AddRelation(api.Incoming, "follows", map[string]interface{}{"weight": 10}, users[4]); err != nil {
+		t.Fatal(err)
+	}
+	for _, algorithm := range []model.TraverseAlgorithm{model.TraverseAlgorithmBfs, model.TraverseAlgorithmDfs} {
+		traversals, err := g.Traverse(&api.TraverseOptions{
+			Start:     model.Key{Type: "user", ID: "a"},
+			Direction: api.Outgoing,
+			Algorithm: algorithm,
+			MinDepth:  2,
+			MaxDepth:  3,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(traversals) != 2 || traversals[0].Node.ID() != "c" || traversals[1].Node.ID() != "d" {
+			t.Fatalf("%s: unexpected traversals: %v", algorithm, len(traversals))
+		}
+		if traversals[1].Depth != 3 || len(traversals[1].Path) != 3 {
+			t.Fatalf("%s: unexpected path to d: %v %v", algorithm, traversals[1].Depth, len(traversals[1].Path))
+		}
+	}
+	traversals, err := g.Traverse(&api.TraverseOptions{
+		Start:    model.Key{Type: "user", ID: "b"},
+		MinDepth: 1,
+		MaxDepth: 5,
+		RelationFilter: []*model.Expression{
+			{
+				Key:      "weight",
+				Operator: model.OperatorLt,
+				Value:    10,
+			},
+		},
+		NodeFilter: []*model.Expression{
+			{
+				Key:      "name",
+				Operator: model.OperatorNeq,
+				Value:    "d",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, traversal := range traversals {
+		ids = append(ids, traversal.Node.ID())
+	}
+	if fmt.Sprint(ids) != "[a c]" {
+		t.Fatalf("unexpected traversals: %v", ids)
+	}
+}
+
+func TestPaths(t *testing.T) {
+	g := newTestDB(t)
+	nodes := map[string]api.Node{}
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		n, err := g.AddNode("city", name, map[string]interface{}{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes[name] = n
+	}
+	// a -1-> b -1-> c -1-> d, a -10-> d, d <-1- e
+	for _, road := range []struct {
+		from, to string
+		distance int
+	}{
+		{"a", "b", 1},
+		{"b", "c", 1},
+		{"c", "d", 1},
+		{"a", "d", 10},
+		{"e", "d", 1},
+	} {
+		if _, err := nodes[road.from].AddRelation(api.Outgoing, "road", map[string]interface{}{"distance": road.distance}, nodes[road.to]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pathIDs := func(path *api.Path) string {
+		var ids []string
+		for _, n := range path.Nodes {
+			ids = append(ids, n.ID())
+		}
+		return fmt.Sprint(ids)
+	}
+	path, err := g.ShortestPath(&api.PathOptions{
+		From:      model.Key{Type: "city", ID: "a"},
+		To:        model.Key{Type: "city", ID: "d"},
+		Direction: api.Outgoing,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pathIDs(path) != "[a d]" || len(path.Relations) != 1 {
+		t.Fatalf("unexpected shortest path: %s", pathIDs(path))
+	}
+	path, err = g.ShortestPath(&api.PathOptions{
+		From: model.Key{Type: "city", ID: "b"},
+		To:   model.Key{Type: "city", ID: "e"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(path.Nodes) != 4 || len(path.Relations) != 3 || path.Nodes[3].ID() != "e" {
+		t.Fatalf("unexpected shortest path: %s", pathIDs(path))
+	}
+	path, err = g.ShortestPath(&api.PathOptions{
+		From:      model.Key{Type: "city", ID: "b"},
+		To:        model.Key{Type: "city", ID: "e"},
+		Direction: api.Outgoing,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != nil {
+		t.Fatalf("expected no path: %s", pathIDs(path))
+	}
+	path, err = g.WeightedPath(&api.PathOptions{
+		From:        model.Key{Type: "city", ID: "a"},
+		To:          model.Key{Type: "city", ID: "d"},
+		Direction:   api.Outgoing,
+		WeightField: "distance",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pathIDs(path) != "[a b c d]" || path.Weight != 3 {
+		t.Fatalf("unexpected weighted path: %s %v", pathIDs(path), path.Weight)
+	}
+	path, err = g.WeightedPath(&api.PathOptions{
+		From:        model.Key{Type: "city", ID: "a"},
+		To:          model.Key{Type: "city", ID: "d"},
+		Direction:   api.Outgoing,
+		MaxDepth:    2,
+		WeightField: "distance",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pathIDs(path) != "[a d]" || path.Weight != 10 {
+		t.Fatalf("unexpected weighted path: %s %v", pathIDs(path), path.Weight)
+	}
+}
@@ -1,281 +1,476 @@
 package persistence
 
 import (
-	"fmt"
+	"bytes"
 	"github.com/autom8ter/morpheus/pkg/api"
 	"github.com/autom8ter/morpheus/pkg/encode"
 	"github.com/autom8ter/morpheus/pkg/graph/model"
 	"github.com/dgraph-io/badger/v3"
 	"github.com/palantir/stacktrace"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
-func (d *DB) rangeEQNodes(where *model.NodeWhere) (string, []api.Node, error) {
-	if where.PageSize == nil {
-		pageSize := 25
-		where.PageSize = &pageSize
+// keyRange is a [start, end) range of keys to iterate over
+type keyRange struct {
+	start []byte
+	end   []byte
+	// fieldPrefix & field are set if the range covers the keys of a field index
+	fieldPrefix []byte
+	field       string
+	// reverse iterates over the range from its end to its start
+	reverse bool
+	// keysOnly doesn't prefetch values, so they're only read if fn asks for them
+	keysOnly bool
+}
+
+// keyRanges are iterated over one after the other
+type keyRanges []keyRange
+
+// index ranks of the expressions that can be answered by a field index - lower ranks are expected to match fewer keys
+const (
+	rankEq = iota
+	rankIn
+	rankBetween
+	rankRange
+	rankUnion
+)
+
+// indexPlan is a set of index ranges that contain every entity matching a where clause
+type indexPlan struct {
+	ranges keyRanges
+	rank   int
+}
+
+// nodeRange returns the ranges of keys to iterate over to find the nodes matching the where clause. If an expression
+// that every matching node must satisfy can be answered by a field index, the ranges are bounded to the matching index
+// keys, otherwise they cover every node of the type.
+func nodeRange(where *model.NodeWhere) keyRanges {
+	fieldPrefix := func(field string) []byte {
+		return getNodeTypeFieldPrefix(where.Type, field)
 	}
-	var (
-		skipped int
-		skip    int
-		err     error
-	)
+	prefix := append(getNodePath(where.Type, ""), ',')
+	scan := keyRange{start: prefix, end: prefixEnd(prefix)}
+	return orderedRange(fieldPrefix, where.OrderBy, planIndex(fieldPrefix, where.Expressions, where.Filter), scan)
+}
 
-	if where.Cursor != nil {
-		skip, err = parseCursor(*where.Cursor)
-		if err != nil {
-			return "", nil, stacktrace.Propagate(err, "")
+// relationRange returns the ranges of keys to iterate over to find the relations matching the where clause
+func relationRange(where *model.RelationWhere) keyRanges {
+	fieldPrefix := func(field string) []byte {
+		return getRelationFieldPrefix(where.Relation, field)
+	}
+	prefix := getRelationPath(where.Relation, "")
+	scan := keyRange{start: prefix, end: prefixEnd(prefix)}
+	return orderedRange(fieldPrefix, where.OrderBy, planIndex(fieldPrefix, where.Expressions, where.Filter), scan)
+}
+
+// orderedRange returns the ranges to iterate over to find the entities in the order of the order by field's index. If
+// the plan only covers the order by field, its ranges are already ordered, otherwise the whole field index is iterated
+// over followed by a scan of the entities without a value for the field. Fields that aren't indexed are left to a
+// boundedSort.
+func orderedRange(fieldPrefix func(field string) []byte, orderBy *model.OrderBy, plan *indexPlan, scan keyRange) keyRanges {
+	if orderBy == nil || !indexedPath(orderBy.Field) {
+		if plan != nil {
+			return plan.ranges
 		}
+		return keyRanges{scan}
 	}
-	evalFunc := func(n api.Node) (bool, error) {
-		passed := true
-		for _, exp := range where.Expressions {
-			passed, err = eval(exp, n)
-			if err != nil {
-				return false, stacktrace.Propagate(err, "")
+	reverse := orderBy.Reverse != nil && *orderBy.Reverse
+	if plan != nil && plan.rank < rankUnion && plan.ranges[0].field == orderBy.Field {
+		ranges := make(keyRanges, len(plan.ranges))
+		for i, kr := range plan.ranges {
+			kr.reverse = reverse
+			if reverse {
+				ranges[len(ranges)-1-i] = kr
+			} else {
+				ranges[i] = kr
 			}
-			if !passed {
+		}
+		return ranges
+	}
+	prefix := fieldPrefix(orderBy.Field)
+	// null values are ordered with the entities that don't have the field
+	ordered := keyRange{
+		start:       bytes.Join([][]byte{prefix, {tagNull + 1}}, nil),
+		end:         prefixEnd(prefix),
+		fieldPrefix: prefix,
+		field:       orderBy.Field,
+		reverse:     reverse,
+	}
+	return keyRanges{ordered, scan}
+}
+
+// planIndex returns the lowest ranked index plan for the expressions & filter, or nil if they can only be answered by
+// a full scan. Expressions that must always be true & OR groups whose branches can all be answered by an index are
+// considered.
+func planIndex(fieldPrefix func(field string) []byte, expressions []*model.Expression, filter *model.Filter) *indexPlan {
+	var (
+		best     *indexPlan
+		orGroups [][]*model.Filter
+	)
+	var conjuncts func(filter *model.Filter)
+	conjuncts = func(filter *model.Filter) {
+		if filter == nil {
+			return
+		}
+		expressions = append(expressions, filter.Expressions...)
+		for _, and := range filter.And {
+			conjuncts(and)
+		}
+		switch len(filter.Or) {
+		case 0:
+		case 1:
+			conjuncts(filter.Or[0])
+		default:
+			orGroups = append(orGroups, filter.Or)
+		}
+	}
+	conjuncts(filter)
+	for _, exp := range expressions {
+		if !indexedPath(exp.Key) {
+			continue
+		}
+		if plan := indexRanges(fieldPrefix(exp.Key), exp); plan != nil && (best == nil || plan.rank < best.rank) {
+			best = plan
+		}
+	}
+	if best != nil && best.rank < rankUnion {
+		return best
+	}
+	for _, branches := range orGroups {
+		union := &indexPlan{rank: rankUnion}
+		for _, branch := range branches {
+			plan := planIndex(fieldPrefix, nil, branch)
+			if plan == nil {
+				union = nil
 				break
 			}
+			union.ranges = append(union.ranges, plan.ranges...)
 		}
-		return passed, nil
-	}
-	var nodes []api.Node
-	for _, exp := range where.Expressions {
-		key := getNodeTypeFieldPath(where.Type, exp.Key, exp.Value, "")
-		if err := d.db.View(func(txn *badger.Txn) error {
-			opt := badger.DefaultIteratorOptions
-			opt.PrefetchSize = prefetchSize
-			it := txn.NewIterator(opt)
-			defer it.Close()
-			for it.Seek(key); it.ValidForPrefix(key); it.Next() {
-				if len(nodes) >= *where.PageSize {
-					return nil
-				}
-				if skipped < skip {
-					skipped++
-					continue
-				}
-				item := it.Item()
-				split := strings.Split(string(item.Key()), ",")
-				nodeID := split[len(split)-1]
-				val, ok := d.cache.Get(string(getNodePath(where.Type, nodeID)))
-				if ok {
-					n := val.(api.Node)
-					passed, err := evalFunc(n)
-					if err != nil {
-						return stacktrace.Propagate(err, "")
-					}
-					if passed {
-						nodes = append(nodes, n)
-					}
-					continue
-				}
-				n := &Node{
-					nodeType: where.Type,
-					nodeID:   nodeID,
-					db:       d,
-				}
-				data := map[string]interface{}{}
-				if err := item.Value(func(val []byte) error {
-					return encode.Unmarshal(val, &data)
-				}); err != nil {
-					return stacktrace.Propagate(err, "")
-				}
-				n.data = data
-				passed, err := evalFunc(n)
-				if err != nil {
-					return err
-				}
-				if passed {
-					nodes = append(nodes, n)
-				}
-			}
-			return nil
-		}); err != nil {
-			return "", nil, err
+		if union != nil {
+			return union
 		}
 	}
+	return best
+}
+
+// indexedPath returns whether the property path may have field index keys. Array elements are indexed under the path
+// of the array, so paths that index into an array(tags.0) never do.
+func indexedPath(path string) bool {
+	for _, segment := range strings.Split(path, ".") {
+		if _, err := strconv.Atoi(segment); err == nil {
+			return false
+		}
+	}
+	return true
+}
 
-	return createCursor(skip + skipped), nodes, nil
+// indexKeys returns the entity's field index keys that are within the range. Array properties have a key per element.
+func (r keyRange) indexKeys(ent api.Entity) ([][]byte, error) {
+	if r.fieldPrefix == nil {
+		return nil, nil
+	}
+	props, err := ent.Properties()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	var keys [][]byte
+	for k, v := range props {
+		fieldIndexValues(k, v, func(field string, value interface{}) {
+			if field != r.field {
+				return
+			}
+			key := getFieldPath(r.fieldPrefix, value, ent.ID())
+			if bytes.Compare(key, r.start) >= 0 && bytes.Compare(key, r.end) < 0 {
+				keys = append(keys, key)
+			}
+		})
+	}
+	return keys, nil
 }
 
-func (d *DB) rangeContainsNodes(where *model.NodeWhere) (string, []api.Node, error) {
-	if where.PageSize == nil {
-		pageSize := 25
-		where.PageSize = &pageSize
+// duplicate returns whether the entity found at the key of the i'th range was already found at a key before it, either
+// in one of the ranges before it or at an earlier key of the same range(an array property with several matching
+// elements)
+func (r keyRanges) duplicate(i int, key []byte, ent api.Entity) (bool, error) {
+	for _, previous := range r[:i] {
+		keys, err := previous.indexKeys(ent)
+		if err != nil || len(keys) > 0 {
+			return len(keys) > 0, err
+		}
+	}
+	keys, err := r[i].indexKeys(ent)
+	if err != nil {
+		return false, err
 	}
+	for _, k := range keys {
+		cmp := bytes.Compare(k, key)
+		if (cmp < 0 && !r[i].reverse) || (cmp > 0 && r[i].reverse) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// iterate calls fn with the index of the range & every key/value in the ranges after the cursor until fn returns false.
+// It returns a seek cursor of the last key that was passed to fn, or nil if there wasn't one.
+func (r keyRanges) iterate(txn *badger.Txn, after *cursor, fn func(i int, item *badger.Item) (bool, error)) (*cursor, error) {
 	var (
-		skipped int
-		skip    int
-		err     error
+		first int
+		seek  []byte
+		skip  int
+		last  *cursor
 	)
+	if after != nil {
+		switch after.kind {
+		case seekCursor:
+			first, seek = after.rangeIndex, after.key
+		case offsetCursor:
+			skip = after.offset
+		default:
+			return nil, stacktrace.NewError("bad cursor: %s cursors can't be used to iterate over keys", after.kind)
+		}
+	}
+	for i := first; i < len(r); i++ {
+		var from []byte
+		if i == first {
+			from = seek
+		}
+		next, err := r[i].iterate(txn, from, func(item *badger.Item) (bool, error) {
+			if skip > 0 {
+				skip--
+				return true, nil
+			}
+			if last == nil {
+				last = &cursor{kind: seekCursor}
+			}
+			last.rangeIndex, last.key = i, item.KeyCopy(last.key)
+			return fn(i, item)
+		})
+		if err != nil {
+			return last, stacktrace.Propagate(err, "")
+		}
+		if !next {
+			break
+		}
+	}
+	return last, nil
+}
+
+// iterate calls fn with every key/value in the range after the given key(or from the start of the range if it's nil)
+// until fn returns false. It returns false if fn did.
+func (r keyRange) iterate(txn *badger.Txn, after []byte, fn func(item *badger.Item) (bool, error)) (bool, error) {
+	opt := badger.DefaultIteratorOptions
+	opt.PrefetchSize = prefetchSize
+	opt.Reverse = r.reverse
+	opt.PrefetchValues = !r.keysOnly
+	it := txn.NewIterator(opt)
+	defer it.Close()
+	inRange := func() bool {
+		return it.Valid() && bytes.Compare(it.Item().Key(), r.start) >= 0 && bytes.Compare(it.Item().Key(), r.end) < 0
+	}
+	// a reverse seek finds the last key <= the key being sought, so in either direction the key that was sought is
+	// skipped if it was the last key of the previous page or the exclusive end of the range
+	seek := after
+	switch {
+	case seek == nil && r.reverse:
+		seek = r.end
+	case seek == nil:
+		seek = r.start
+	}
+	it.Seek(seek)
+	if it.Valid() && (after != nil || r.reverse) && bytes.Equal(it.Item().Key(), seek) {
+		it.Next()
+	}
+	for ; inRange(); it.Next() {
+		next, err := fn(it.Item())
+		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+		if !next {
+			return false, nil
+		}
+	}
+	return true, nil
+}
 
-	if where.Cursor != nil {
-		skip, err = parseCursor(*where.Cursor)
+func evalAll(expressions []*model.Expression, ent api.Entity, regexes map[string]*regexp.Regexp) (bool, error) {
+	for _, exp := range expressions {
+		passed, err := eval(exp, ent, regexes)
 		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+		if !passed {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// matches returns whether the entity matches every expression & the filter
+func matches(expressions []*model.Expression, filter *model.Filter, ent api.Entity, regexes map[string]*regexp.Regexp) (bool, error) {
+	passed, err := evalAll(expressions, ent, regexes)
+	if err != nil || !passed {
+		return false, err
+	}
+	return evalFilter(filter, ent, regexes)
+}
+
+// itemNode returns the node of a node or node field index key/value
+func (d *DB) itemNode(nodeType string, item *badger.Item) (api.Node, error) {
+	nodeID := getIndexedID(item.Key())
+	if val, ok := d.cache.Get(string(getNodePath(nodeType, nodeID))); ok {
+		return val.(api.Node), nil
+	}
+	data := map[string]interface{}{}
+	if err := item.Value(func(val []byte) error {
+		return encode.Unmarshal(val, &data)
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &Node{
+		nodeType: nodeType,
+		nodeID:   nodeID,
+		data:     data,
+		db:       d,
+	}, nil
+}
+
+// itemRelation returns the relation of a relation, relation field index or node-relation key/value
+func (d *DB) itemRelation(relation string, item *badger.Item) (api.Relation, error) {
+	relationID := getIndexedID(item.Key())
+	if val, ok := d.cache.Get(string(getRelationPath(relation, relationID))); ok {
+		return val.(api.Relation), nil
+	}
+	data := map[string]interface{}{}
+	if err := item.Value(func(val []byte) error {
+		return encode.Unmarshal(val, &data)
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &Relation{
+		relationType: relation,
+		relationID:   relationID,
+		item:         data,
+		db:           d,
+	}, nil
+}
+
+func (d *DB) rangeNodes(where *model.NodeWhere, kr keyRanges) (string, []api.Node, error) {
+	var (
+		last  *cursor
+		nodes []api.Node
+	)
+	after, err := parseCursor(where.Cursor)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+	regexes, err := compileRegexes(where.Expressions, where.Filter)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+	sorter := newBoundedSort(where.OrderBy, *where.PageSize, true)
+	if sorter != nil {
+		// every entity is sorted, so the cursor is the position in the sort rather than a key
+		if err := sorter.resume(after); err != nil {
 			return "", nil, stacktrace.Propagate(err, "")
 		}
+		after = nil
 	}
-	evalFunc := func(n api.Node) (bool, error) {
-		passed := true
-		for _, exp := range where.Expressions {
-			passed, err = eval(exp, n)
+	if err := d.db.View(func(txn *badger.Txn) error {
+		last, err = kr.iterate(txn, after, func(i int, item *badger.Item) (bool, error) {
+			n, err := d.itemNode(where.Type, item)
 			if err != nil {
 				return false, stacktrace.Propagate(err, "")
 			}
-			if !passed {
-				break
+			passed, err := matches(where.Expressions, where.Filter, n, regexes)
+			if err != nil {
+				return false, stacktrace.Propagate(err, "")
 			}
-		}
-		return passed, nil
-	}
-	var nodes []api.Node
-	for _, exp := range where.Expressions {
-		key := getNodeTypeFieldPath(where.Type, exp.Key, "", "")
-		if err := d.db.View(func(txn *badger.Txn) error {
-			opt := badger.DefaultIteratorOptions
-			opt.PrefetchSize = prefetchSize
-			it := txn.NewIterator(opt)
-			defer it.Close()
-			for it.Seek(key); it.ValidForPrefix(key); it.Next() {
-				if len(nodes) >= *where.PageSize {
-					return nil
-				}
-				if skipped < skip {
-					skipped++
-					continue
-				}
-				item := it.Item()
-				split := strings.Split(string(item.Key()), ",")
-				nodeID := split[len(split)-1]
-				val, ok := d.cache.Get(string(getNodePath(where.Type, nodeID)))
-				if ok {
-					n := val.(api.Node)
-					passed, err := evalFunc(n)
-					if err != nil {
-						return stacktrace.Propagate(err, "")
-					}
-					if passed {
-						nodes = append(nodes, n)
-					}
-					continue
-				}
-				n := &Node{
-					nodeType: where.Type,
-					nodeID:   nodeID,
-					db:       d,
-				}
-				data := map[string]interface{}{}
-				if err := item.Value(func(val []byte) error {
-					return encode.Unmarshal(val, &data)
-				}); err != nil {
-					return stacktrace.Propagate(err, "")
-				}
-				n.data = data
-				passed, err := evalFunc(n)
+			if passed {
+				duplicate, err := kr.duplicate(i, item.Key(), n)
 				if err != nil {
-					return err
-				}
-				if passed {
-					nodes = append(nodes, n)
+					return false, stacktrace.Propagate(err, "")
 				}
+				passed = !duplicate
+			}
+			if passed && sorter != nil {
+				return true, sorter.add(n)
 			}
-			return nil
-		}); err != nil {
-			return "", nil, err
+			if passed {
+				nodes = append(nodes, n)
+			}
+			return len(nodes) < *where.PageSize, nil
+		})
+		return err
+	}); err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+	if sorter != nil {
+		for _, ent := range sorter.page() {
+			nodes = append(nodes, ent.(api.Node))
 		}
+		return nextCursor(sorter.cursor(), where.Cursor), nodes, nil
 	}
-
-	return createCursor(skip + skipped), nodes, nil
+	return nextCursor(last, where.Cursor), nodes, nil
 }
 
-func (d *DB) rangeHasPrefixNodes(where *model.NodeWhere) (string, []api.Node, error) {
-	if where.PageSize == nil {
-		pageSize := 25
-		where.PageSize = &pageSize
-	}
+func (d *DB) rangeRelations(where *model.RelationWhere, kr keyRanges) (string, []api.Relation, error) {
 	var (
-		skipped int
-		skip    int
-		err     error
+		last *cursor
+		rels []api.Relation
 	)
-
-	if where.Cursor != nil {
-		skip, err = parseCursor(*where.Cursor)
-		if err != nil {
+	after, err := parseCursor(where.Cursor)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+	regexes, err := compileRegexes(where.Expressions, where.Filter)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+	sorter := newBoundedSort(where.OrderBy, *where.PageSize, true)
+	if sorter != nil {
+		// every entity is sorted, so the cursor is the position in the sort rather than a key
+		if err := sorter.resume(after); err != nil {
 			return "", nil, stacktrace.Propagate(err, "")
 		}
+		after = nil
 	}
-	evalFunc := func(n api.Node) (bool, error) {
-		passed := true
-		for _, exp := range where.Expressions {
-			passed, err = eval(exp, n)
+	if err := d.db.View(func(txn *badger.Txn) error {
+		last, err = kr.iterate(txn, after, func(i int, item *badger.Item) (bool, error) {
+			rel, err := d.itemRelation(where.Relation, item)
 			if err != nil {
 				return false, stacktrace.Propagate(err, "")
 			}
-			if !passed {
-				break
+			passed, err := matches(where.Expressions, where.Filter, rel, regexes)
+			if err != nil {
+				return false, stacktrace.Propagate(err, "")
 			}
-		}
-		return passed, nil
-	}
-	var nodes []api.Node
-	for _, exp := range where.Expressions {
-		key := getNodeTypeFieldPath(where.Type, exp.Key, fmt.Sprint(exp.Value), "")
-		if err := d.db.View(func(txn *badger.Txn) error {
-			opt := badger.DefaultIteratorOptions
-			opt.PrefetchSize = prefetchSize
-			it := txn.NewIterator(opt)
-			defer it.Close()
-			for it.Seek(key); it.ValidForPrefix(key); it.Next() {
-				if len(nodes) >= *where.PageSize {
-					return nil
-				}
-				if skipped < skip {
-					skipped++
-					continue
-				}
-				item := it.Item()
-				split := strings.Split(string(item.Key()), ",")
-				nodeID := split[len(split)-1]
-				val, ok := d.cache.Get(string(getNodePath(where.Type, nodeID)))
-				if ok {
-					n := val.(api.Node)
-					passed, err := evalFunc(n)
-					if err != nil {
-						return stacktrace.Propagate(err, "")
-					}
-					if passed {
-						nodes = append(nodes, n)
-					}
-					continue
-				}
-				n := &Node{
-					nodeType: where.Type,
-					nodeID:   nodeID,
-					db:       d,
-				}
-				data := map[string]interface{}{}
-				if err := item.Value(func(val []byte) error {
-					return encode.Unmarshal(val, &data)
-				}); err != nil {
-					return stacktrace.Propagate(err, "")
-				}
-				n.data = data
-				passed, err := evalFunc(n)
+			if passed {
+				duplicate, err := kr.duplicate(i, item.Key(), rel)
 				if err != nil {
-					return err
-				}
-				if passed {
-					nodes = append(nodes, n)
+					return false, stacktrace.Propagate(err, "")
 				}
+				passed = !duplicate
 			}
-			return nil
-		}); err != nil {
-			return "", nil, err
+			if passed && sorter != nil {
+				return true, sorter.add(rel)
+			}
+			if passed {
+				rels = append(rels, rel)
+			}
+			return len(rels) < *where.PageSize, nil
+		})
+		return err
+	}); err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+	if sorter != nil {
+		for _, ent := range sorter.page() {
+			rels = append(rels, ent.(api.Relation))
 		}
+		return nextCursor(sorter.cursor(), where.Cursor), rels, nil
 	}
-
-	return createCursor(skip + skipped), nodes, nil
+	return nextCursor(last, where.Cursor), rels, nil
 }
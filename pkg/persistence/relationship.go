@@ -4,6 +4,8 @@ import (
 	"github.com/autom8ter/morpheus/pkg/api"
 	"github.com/autom8ter/morpheus/pkg/constants"
 	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/autom8ter/morpheus/pkg/helpers"
 	"github.com/dgraph-io/badger/v3"
 	"github.com/palantir/stacktrace"
 	"github.com/spf13/cast"
@@ -56,32 +58,37 @@ func (n *Relation) GetProperty(name string) (interface{}, error) {
 	if all == nil {
 		return nil, stacktrace.Propagate(constants.ErrNotFound, "")
 	}
-	return all[name], nil
+	return helpers.Lookup(all, name), nil
 }
 
 func (n *Relation) SetProperties(properties map[string]interface{}) error {
-
-	bits, err := encode.Marshal(properties)
-	if err != nil {
+	var r *Relation
+	if err := n.db.update(func(t *tx) error {
+		var err error
+		r, err = n.db.setRelation(t, n.relationType, n.relationID, properties)
+		return err
+	}); err != nil {
 		return stacktrace.Propagate(err, "")
 	}
-	var key = getRelationPath(n.relationType, n.relationID)
-	if err := n.db.db.Update(func(txn *badger.Txn) error {
-		return txn.Set(key, bits)
+	n.item = r.item
+	return nil
+}
+
+func (n *Relation) PatchProperties(patch *model.Patch) error {
+	var r *Relation
+	if err := n.db.update(func(t *tx) error {
+		var err error
+		r, err = n.db.patchRelation(t, n.relationType, n.relationID, patch)
+		return err
 	}); err != nil {
 		return stacktrace.Propagate(err, "")
 	}
-	n.item = properties
+	n.item = r.item
 	return nil
 }
 
 func (n *Relation) DelProperty(name string) error {
-	all, err := n.Properties()
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-	delete(all, name)
-	if err := n.SetProperties(all); err != nil {
+	if err := n.PatchProperties(&model.Patch{Set: map[string]interface{}{name: nil}}); err != nil {
 		return stacktrace.Propagate(err, "")
 	}
 	return nil
@@ -129,3 +136,35 @@ func (r Relation) Target() (api.Node, error) {
 	}
 	return n, nil
 }
+
+// delRelation deletes the relation, its field indexes and the node-relation keys of both of its nodes
+func delRelation(txn *badger.Txn, props map[string]interface{}) error {
+	var (
+		relation   = cast.ToString(props[Internal_Relation])
+		relationID = cast.ToString(props[Internal_ID])
+		direction  = api.Direction(cast.ToString(props[Internal_Direction]))
+		sourceType = cast.ToString(props[Internal_SourceType])
+		sourceID   = cast.ToString(props[Internal_SourceID])
+		targetType = cast.ToString(props[Internal_TargetType])
+		targetID   = cast.ToString(props[Internal_TargetID])
+	)
+	keys := [][]byte{
+		getRelationPath(relation, relationID),
+		getNodeRelationPath(sourceType, sourceID, direction, relation, targetType, targetID, relationID),
+		getNodeRelationPath(targetType, targetID, direction.Opposite(), relation, sourceType, sourceID, relationID),
+	}
+	keys = append(keys, getRelationFieldPaths(relation, props, relationID)...)
+	if _, err := txn.Get(keys[0]); err == nil {
+		if err := addCount(txn, getCountPath(relationPrefix, relation), -1); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	} else if err != badger.ErrKeyNotFound {
+		return stacktrace.Propagate(err, "key=%s", string(keys[0]))
+	}
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return stacktrace.Propagate(err, "key=%s", string(key))
+		}
+	}
+	return nil
+}
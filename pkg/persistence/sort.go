@@ -0,0 +1,149 @@
+package persistence
+
+import (
+	"bytes"
+	"container/heap"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/palantir/stacktrace"
+	"sort"
+	"strings"
+)
+
+// sortItem is an entity & the encoded value of its order by field, which is nil if it doesn't have one
+type sortItem struct {
+	key []byte
+	id  string
+	ent api.Entity
+}
+
+// boundedSort orders entities by a field that can't be streamed from a field index. Only the entities after the
+// cursor that fit in the page are kept, so a page is sorted in memory proportional to its size rather than the number
+// of entities. Entities are ordered the same way they would be by the field index: by value & then ID, with entities
+// without a value last.
+type boundedSort struct {
+	field   string
+	reverse bool
+	limit   int
+	items   []*sortItem
+	// after is the last entity of the previous page
+	after *sortItem
+	// offset is the number of entities to skip for deprecated offset cursors
+	offset int
+	last   *sortItem
+}
+
+// newBoundedSort returns a sort for the order by clause, or nil if the entities are already iterated over in order
+func newBoundedSort(orderBy *model.OrderBy, limit int, indexed bool) *boundedSort {
+	if orderBy == nil || (indexed && indexedPath(orderBy.Field)) {
+		return nil
+	}
+	return &boundedSort{
+		field:   orderBy.Field,
+		reverse: orderBy.Reverse != nil && *orderBy.Reverse,
+		limit:   limit,
+	}
+}
+
+func (s *boundedSort) add(ent api.Entity) error {
+	val, err := ent.GetProperty(s.field)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	item := &sortItem{id: ent.ID(), ent: ent}
+	// array properties are ordered by their first element in the sort order
+	fieldIndexValues(s.field, val, func(field string, value interface{}) {
+		key := encodeIndexValue(value)
+		if key[0] == tagNull {
+			return
+		}
+		if item.key == nil || (bytes.Compare(key, item.key) < 0) != s.reverse {
+			item.key = key
+		}
+	})
+	if s.after != nil && !s.before(s.after, item) {
+		return nil
+	}
+	if len(s.items) < s.limit {
+		heap.Push(s, item)
+		return nil
+	}
+	if len(s.items) > 0 && s.before(item, s.items[0]) {
+		s.items[0] = item
+		heap.Fix(s, 0)
+	}
+	return nil
+}
+
+// resume continues the sort from the page that ended at the cursor
+func (s *boundedSort) resume(c *cursor) error {
+	if c == nil {
+		return nil
+	}
+	switch c.kind {
+	case sortCursor:
+		s.after = c.item
+	case offsetCursor:
+		s.offset = c.offset
+		s.limit += c.offset
+	default:
+		return stacktrace.NewError("bad cursor: %s cursors can't be used to sort", c.kind)
+	}
+	return nil
+}
+
+// page returns the sorted entities of the page
+func (s *boundedSort) page() []api.Entity {
+	sort.Slice(s.items, func(i, j int) bool {
+		return s.before(s.items[i], s.items[j])
+	})
+	var entities []api.Entity
+	for i := s.offset; i < len(s.items); i++ {
+		entities = append(entities, s.items[i].ent)
+		s.last = s.items[i]
+	}
+	return entities
+}
+
+// cursor returns the cursor of the last entity of the page, or nil if the page was empty
+func (s *boundedSort) cursor() *cursor {
+	if s.last == nil {
+		return nil
+	}
+	return &cursor{kind: sortCursor, item: s.last}
+}
+
+// before returns whether a is ordered before b
+func (s *boundedSort) before(a, b *sortItem) bool {
+	if a.key == nil || b.key == nil {
+		if a.key != nil || b.key != nil {
+			return b.key == nil
+		}
+		return strings.Compare(a.id, b.id) < 0
+	}
+	cmp := bytes.Compare(a.key, b.key)
+	if cmp == 0 {
+		cmp = strings.Compare(a.id, b.id)
+	}
+	if s.reverse {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// Len, Less, Swap, Push & Pop implement a heap with the item ordered last at the top, which is the one replaced when
+// an item ordered before it is added to a full sort
+
+func (s *boundedSort) Len() int { return len(s.items) }
+
+func (s *boundedSort) Less(i, j int) bool { return s.before(s.items[j], s.items[i]) }
+
+func (s *boundedSort) Swap(i, j int) { s.items[i], s.items[j] = s.items[j], s.items[i] }
+
+func (s *boundedSort) Push(x interface{}) { s.items = append(s.items, x.(*sortItem)) }
+
+func (s *boundedSort) Pop() interface{} {
+	item := s.items[len(s.items)-1]
+	s.items = s.items[:len(s.items)-1]
+	return item
+}
@@ -0,0 +1,173 @@
+package persistence
+
+import (
+	"context"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/autom8ter/morpheus/pkg/logger"
+	"github.com/palantir/stacktrace"
+	"regexp"
+	"sync"
+)
+
+// subscriptionBuffer is the number of changes buffered for each subscriber. Changes are dropped for subscribers that
+// fall further behind so that a slow subscriber never blocks the FSM.
+const subscriptionBuffer = 1000
+
+type subscription struct {
+	mu          sync.RWMutex
+	closed      bool
+	prefix      string
+	typee       string
+	expressions []*model.Expression
+	regexes     map[string]*regexp.Regexp
+	nodes       chan *model.NodeChange
+	relations   chan *model.RelationChange
+}
+
+func (s *subscription) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if s.nodes != nil {
+		close(s.nodes)
+	}
+	if s.relations != nil {
+		close(s.relations)
+	}
+}
+
+func (d *DB) SubscribeNodes(ctx context.Context, nodeType string, expressions []*model.Expression) (<-chan *model.NodeChange, error) {
+	if nodeType == "" {
+		return nil, stacktrace.NewError("empty node type")
+	}
+	regexes, err := compileRegexes(expressions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	s := &subscription{
+		prefix:      nodesPrefix,
+		typee:       nodeType,
+		expressions: expressions,
+		regexes:     regexes,
+		nodes:       make(chan *model.NodeChange, subscriptionBuffer),
+	}
+	d.subscribe(ctx, s)
+	return s.nodes, nil
+}
+
+func (d *DB) SubscribeRelations(ctx context.Context, relation string, expressions []*model.Expression) (<-chan *model.RelationChange, error) {
+	if relation == "" {
+		return nil, stacktrace.NewError("empty relation")
+	}
+	regexes, err := compileRegexes(expressions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	s := &subscription{
+		prefix:      relationPrefix,
+		typee:       relation,
+		expressions: expressions,
+		regexes:     regexes,
+		relations:   make(chan *model.RelationChange, subscriptionBuffer),
+	}
+	d.subscribe(ctx, s)
+	return s.relations, nil
+}
+
+func (d *DB) subscribe(ctx context.Context, s *subscription) {
+	d.subscriptions.Store(s, struct{}{})
+	go func() {
+		<-ctx.Done()
+		d.subscriptions.Delete(s)
+		s.close()
+	}()
+}
+
+// publishNode sends the change to every subscriber of the node's type whose expressions match the node's properties.
+// Properties are those after a SET and before a DELETE.
+func (d *DB) publishNode(change model.ChangeType, nodeType, nodeID string, properties map[string]interface{}) {
+	n := &Node{
+		nodeType: nodeType,
+		nodeID:   nodeID,
+		data:     copyProperties(properties),
+		db:       d,
+	}
+	d.publish(nodesPrefix, nodeType, n, func(s *subscription) bool {
+		select {
+		case s.nodes <- &model.NodeChange{
+			Change:     change,
+			Type:       nodeType,
+			ID:         nodeID,
+			Properties: n.data,
+		}:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+// publishRelation sends the change to every subscriber of the relation whose expressions match the relation's
+// properties. Properties are those after a SET and before a DELETE.
+func (d *DB) publishRelation(change model.ChangeType, relation, relationID string, properties map[string]interface{}) {
+	r := &Relation{
+		relationType: relation,
+		relationID:   relationID,
+		item:         copyProperties(properties),
+		db:           d,
+	}
+	d.publish(relationPrefix, relation, r, func(s *subscription) bool {
+		select {
+		case s.relations <- &model.RelationChange{
+			Change:     change,
+			Type:       relation,
+			ID:         relationID,
+			Properties: r.item,
+		}:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+func (d *DB) publish(prefix, typee string, ent api.Entity, send func(s *subscription) bool) {
+	d.subscriptions.Range(func(key, value interface{}) bool {
+		s := key.(*subscription)
+		if s.prefix != prefix || s.typee != typee {
+			return true
+		}
+		passed, err := evalAll(s.expressions, ent, s.regexes)
+		if err != nil {
+			logger.L.Error("failed to evaluate subscription expressions", stacktrace.Propagate(err, ""), map[string]interface{}{
+				"type": typee,
+				"id":   ent.ID(),
+			})
+			return true
+		}
+		if !passed {
+			return true
+		}
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		if s.closed {
+			return true
+		}
+		if !send(s) {
+			logger.L.Warn("subscriber is too slow - dropped change", map[string]interface{}{
+				"type": typee,
+				"id":   ent.ID(),
+			})
+		}
+		return true
+	})
+}
+
+func copyProperties(properties map[string]interface{}) map[string]interface{} {
+	cp := make(map[string]interface{}, len(properties))
+	for k, v := range properties {
+		cp[k] = v
+	}
+	return cp
+}
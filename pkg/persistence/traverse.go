@@ -0,0 +1,291 @@
+package persistence
+
+import (
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/palantir/stacktrace"
+	"github.com/spf13/cast"
+	"regexp"
+	"strings"
+)
+
+const (
+	defaultTraverseDepth = 1
+	defaultTraverseLimit = 100
+)
+
+// visit is a node reached by a traversal. parent & relation point back along the path that reached it.
+type visit struct {
+	node     api.Node
+	depth    int
+	parent   *visit
+	relation api.Relation
+}
+
+func (v *visit) path() []api.Relation {
+	var path []api.Relation
+	for current := v; current.parent != nil; current = current.parent {
+		path = append([]api.Relation{current.relation}, path...)
+	}
+	return path
+}
+
+// edge is a relation from a visited node to one of its neighbors
+type edge struct {
+	relation     api.Relation
+	neighborType string
+	neighborID   string
+}
+
+func (d *DB) Traverse(opts *api.TraverseOptions) ([]*api.Traversal, error) {
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = defaultTraverseDepth
+	}
+	if opts.MinDepth < 0 {
+		opts.MinDepth = 0
+	}
+	if opts.MinDepth > opts.MaxDepth {
+		return nil, stacktrace.NewError("minDepth(%v) is greater than maxDepth(%v)", opts.MinDepth, opts.MaxDepth)
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = defaultTraverseLimit
+	}
+	regexes, err := compileRegexes(append(append([]*model.Expression{}, opts.NodeFilter...), opts.RelationFilter...))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	start, err := d.GetNode(opts.Start.Type, opts.Start.ID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to get start node")
+	}
+	var visits []*visit
+	if err := d.db.View(func(txn *badger.Txn) error {
+		switch opts.Algorithm {
+		case model.TraverseAlgorithmDfs:
+			visits, err = d.traverseDFS(txn, start, opts, regexes)
+		default:
+			visits, err = d.traverseBFS(txn, start, opts, regexes)
+		}
+		return err
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	var traversals []*api.Traversal
+	for _, v := range visits {
+		if v.depth < opts.MinDepth {
+			continue
+		}
+		if len(traversals) >= opts.Limit {
+			break
+		}
+		traversals = append(traversals, &api.Traversal{
+			Node:  v.node,
+			Depth: v.depth,
+			Path:  v.path(),
+		})
+	}
+	return traversals, nil
+}
+
+// traverseBFS visits nodes in order of their distance from the start node, so each node's depth is its shortest
+// distance. It stops as soon as enough nodes have been visited to satisfy the limit.
+func (d *DB) traverseBFS(txn *badger.Txn, start api.Node, opts *api.TraverseOptions, regexes map[string]*regexp.Regexp) ([]*visit, error) {
+	var (
+		root    = &visit{node: start}
+		visits  = []*visit{root}
+		seen    = map[string]struct{}{string(getNodePath(start.Type(), start.ID())): {}}
+		queue   = []*visit{root}
+		matched int
+	)
+	if opts.MinDepth == 0 {
+		matched++
+	}
+	for len(queue) > 0 && matched < opts.Limit {
+		current := queue[0]
+		queue = queue[1:]
+		if current.depth >= opts.MaxDepth {
+			continue
+		}
+		edges, err := d.edges(txn, current.node.Type(), current.node.ID(), opts.Relations, opts.Direction, opts.RelationFilter, regexes)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		for _, e := range edges {
+			key := string(getNodePath(e.neighborType, e.neighborID))
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			neighbor, ok, err := d.visitable(e, opts, regexes)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "")
+			}
+			if !ok {
+				continue
+			}
+			next := &visit{node: neighbor, depth: current.depth + 1, parent: current, relation: e.relation}
+			visits = append(visits, next)
+			queue = append(queue, next)
+			if next.depth >= opts.MinDepth {
+				matched++
+			}
+		}
+	}
+	return visits, nil
+}
+
+// traverseDFS visits nodes depth first. A node that is reached again by a shorter path is revisited so that every node
+// within maxDepth is found & its depth is its shortest distance.
+func (d *DB) traverseDFS(txn *badger.Txn, start api.Node, opts *api.TraverseOptions, regexes map[string]*regexp.Regexp) ([]*visit, error) {
+	var (
+		root    = &visit{node: start}
+		visits  = []*visit{root}
+		visited = map[string]*visit{string(getNodePath(start.Type(), start.ID())): root}
+		walk    func(current *visit) error
+	)
+	walk = func(current *visit) error {
+		if current.depth >= opts.MaxDepth {
+			return nil
+		}
+		edges, err := d.edges(txn, current.node.Type(), current.node.ID(), opts.Relations, opts.Direction, opts.RelationFilter, regexes)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		for _, e := range edges {
+			key := string(getNodePath(e.neighborType, e.neighborID))
+			next, ok := visited[key]
+			if ok {
+				if next == nil || next.depth <= current.depth+1 {
+					continue
+				}
+				next.depth = current.depth + 1
+				next.parent = current
+				next.relation = e.relation
+			} else {
+				neighbor, ok, err := d.visitable(e, opts, regexes)
+				if err != nil {
+					return stacktrace.Propagate(err, "")
+				}
+				if !ok {
+					// remember nodes that were filtered out so they're only evaluated once
+					visited[key] = nil
+					continue
+				}
+				next = &visit{node: neighbor, depth: current.depth + 1, parent: current, relation: e.relation}
+				visited[key] = next
+				visits = append(visits, next)
+			}
+			if err := walk(next); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(root); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return visits, nil
+}
+
+func (d *DB) Neighbors(key model.Key, relations []string, direction api.Direction) ([]*api.Neighbor, error) {
+	var edges []*edge
+	if err := d.db.View(func(txn *badger.Txn) error {
+		var err error
+		edges, err = d.edges(txn, key.Type, key.ID, relations, direction, nil, nil)
+		return err
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	var neighbors []*api.Neighbor
+	for _, e := range edges {
+		n, err := d.GetNode(e.neighborType, e.neighborID)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "failed to get node %s %s", e.neighborType, e.neighborID)
+		}
+		neighbors = append(neighbors, &api.Neighbor{Relation: e.relation, Node: n})
+	}
+	return neighbors, nil
+}
+
+// edges returns the relations of the node that match the relation types, direction & relation filter. Empty relation
+// types & direction match every relation.
+func (d *DB) edges(txn *badger.Txn, nodeType, nodeID string, relations []string, dir api.Direction, filter []*model.Expression, regexes map[string]*regexp.Regexp) ([]*edge, error) {
+	var edges []*edge
+	prefix := getNodeRelationsPrefix(nodeType, nodeID)
+	it := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer it.Close()
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		item := it.Item()
+		// 3,type,id,direction,relation,targetType,targetID,relationID
+		split := strings.Split(string(item.Key()), ",")
+		if len(split) != 8 {
+			continue
+		}
+		relation, relationID := split[4], split[7]
+		if len(relations) > 0 && !containsString(relations, relation) {
+			continue
+		}
+		props := map[string]interface{}{}
+		if err := item.Value(func(val []byte) error {
+			return encode.Unmarshal(val, &props)
+		}); err != nil {
+			return nil, stacktrace.Propagate(err, "key=%s", string(item.Key()))
+		}
+		// the direction is determined from the relation's source rather than the key so that it's relative to the
+		// node being traversed
+		direction := api.Incoming
+		if cast.ToString(props[Internal_SourceType]) == nodeType && cast.ToString(props[Internal_SourceID]) == nodeID {
+			direction = api.Outgoing
+		}
+		if dir != "" && dir != direction {
+			continue
+		}
+		var rel api.Relation
+		if cached, ok := d.cache.Get(string(getRelationPath(relation, relationID))); ok {
+			rel = cached.(api.Relation)
+		} else {
+			rel = &Relation{
+				relationType: relation,
+				relationID:   relationID,
+				db:           d,
+			}
+		}
+		passed, err := evalAll(filter, rel, regexes)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if !passed {
+			continue
+		}
+		edges = append(edges, &edge{
+			relation:     rel,
+			neighborType: split[5],
+			neighborID:   split[6],
+		})
+	}
+	return edges, nil
+}
+
+// visitable returns the neighbor at the end of the edge if it matches the traversal's node filter
+func (d *DB) visitable(e *edge, opts *api.TraverseOptions, regexes map[string]*regexp.Regexp) (api.Node, bool, error) {
+	neighbor, err := d.GetNode(e.neighborType, e.neighborID)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "failed to get node %s %s", e.neighborType, e.neighborID)
+	}
+	passed, err := evalAll(opts.NodeFilter, neighbor, regexes)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "")
+	}
+	return neighbor, passed, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
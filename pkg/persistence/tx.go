@@ -0,0 +1,519 @@
+package persistence
+
+import (
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/constants"
+	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/palantir/stacktrace"
+	"github.com/spf13/cast"
+)
+
+// tx is a badger write transaction along with the changes to make to the cache, catalog & subscriptions once it has
+// been committed
+type tx struct {
+	txn *badger.Txn
+	// index is the raft log index of the transaction, or 0 if it wasn't applied through raft
+	index    uint64
+	onCommit []func()
+	// cached is the last value written to each key within the transaction(nil if it was deleted)
+	cached map[string]interface{}
+}
+
+func (t *tx) afterCommit(fn func()) {
+	t.onCommit = append(t.onCommit, fn)
+}
+
+// cache sets the cached value of the key once the transaction is committed. Only the last value set for a key within
+// the transaction is cached.
+func (t *tx) cache(key []byte, val interface{}) {
+	t.cached[string(key)] = val
+}
+
+// nextVersion returns the version of an entity being written: the raft log index of the transaction, unless the
+// entity's previous version is already at or beyond it
+func (t *tx) nextVersion(existing map[string]interface{}) int64 {
+	version := cast.ToInt64(existing[Internal_Version]) + 1
+	if int64(t.index) > version {
+		version = int64(t.index)
+	}
+	return version
+}
+
+// checkVersion fails with ErrVersionConflict if ifVersion is set & the entity stored at the key has a different
+// version. Entities that don't exist have a version of 0.
+func (t *tx) checkVersion(key []byte, ifVersion *int) error {
+	if ifVersion == nil {
+		return nil
+	}
+	existing, err := getData(t.txn, key)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if version := cast.ToInt64(existing[Internal_Version]); version != int64(*ifVersion) {
+		return stacktrace.Propagate(constants.ErrVersionConflict, "key=%s version=%v ifVersion=%v", string(key), version, *ifVersion)
+	}
+	return nil
+}
+
+// update runs fn within a single write transaction - either every write made by fn is committed or none of them are
+func (d *DB) update(fn func(t *tx) error) error {
+	return d.updateAt(0, fn)
+}
+
+// updateAt runs fn within a single write transaction at the raft log index
+func (d *DB) updateAt(index uint64, fn func(t *tx) error) error {
+	t := &tx{index: index}
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		t.txn = txn
+		t.onCommit = nil
+		t.cached = map[string]interface{}{}
+		if err := fn(t); err != nil {
+			return err
+		}
+		if index > 0 {
+			return setAppliedIndex(txn, index)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	for key, val := range t.cached {
+		if val == nil {
+			d.cache.Del(key)
+		} else {
+			d.cache.Set(key, val, 1)
+		}
+	}
+	for _, fn := range t.onCommit {
+		fn()
+	}
+	return nil
+}
+
+// getData returns the properties stored at the key, or nil if the key doesn't exist
+func getData(txn *badger.Txn, key []byte) (map[string]interface{}, error) {
+	item, err := txn.Get(key)
+	if err == badger.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "key=%s", string(key))
+	}
+	data := map[string]interface{}{}
+	if err := item.Value(func(val []byte) error {
+		return encode.Unmarshal(val, &data)
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "key=%s", string(key))
+	}
+	return data, nil
+}
+
+func (d *DB) addNode(t *tx, nodeType, nodeID string, properties map[string]interface{}) (*Node, error) {
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+	if err := d.validate(nodesPrefix, nodeType, properties, nil); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	key := getNodePath(nodeType, nodeID)
+	existing, err := getData(t.txn, key)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if err := d.indexUnique(t, nodeType, nodeID, existing, properties); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if existing == nil {
+		if err := addCount(t.txn, getCountPath(nodesPrefix, nodeType), 1); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	properties[Internal_ID] = nodeID
+	properties[Internal_Type] = nodeType
+	properties[Internal_Version] = t.nextVersion(existing)
+	bits, err := encode.Marshal(properties)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if err := t.txn.Set(key, bits); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	commitCatalog, err := d.catalog(t.txn, nodeCatalogEntries(nodeType, properties))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	t.afterCommit(commitCatalog)
+	indexes := getNodeTypeFieldPaths(nodeType, properties, nodeID)
+	if err := delStale(t.txn, getNodeTypeFieldPaths(nodeType, existing, nodeID), indexes); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	for _, key := range indexes {
+		if err := t.txn.Set(key, bits); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	n := &Node{
+		nodeType: nodeType,
+		nodeID:   nodeID,
+		data:     properties,
+		db:       d,
+	}
+	t.cache(key, n)
+	t.afterCommit(func() {
+		d.publishNode(model.ChangeTypeSet, nodeType, nodeID, properties)
+	})
+	return n, nil
+}
+
+// delStale deletes the index keys of the previous version of a node or relation that aren't index keys of the new
+// version
+func delStale(txn *badger.Txn, previous, current [][]byte) error {
+	keep := make(map[string]struct{}, len(current))
+	for _, key := range current {
+		keep[string(key)] = struct{}{}
+	}
+	for _, key := range previous {
+		if _, ok := keep[string(key)]; ok {
+			continue
+		}
+		if err := txn.Delete(key); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	return nil
+}
+
+// patchNode applies the patch to the properties of an existing node
+func (d *DB) patchNode(t *tx, nodeType, nodeID string, patch *model.Patch) (*Node, error) {
+	existing, err := getData(t.txn, getNodePath(nodeType, nodeID))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if existing == nil {
+		return nil, stacktrace.Propagate(constants.ErrNotFound, "node %s %s", nodeType, nodeID)
+	}
+	props, err := patchProperties(existing, patch)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return d.addNode(t, nodeType, nodeID, props)
+}
+
+func (d *DB) delNode(t *tx, nodeType, nodeID string, detach bool) error {
+	key := getNodePath(nodeType, nodeID)
+	data, err := getData(t.txn, key)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if data == nil {
+		return stacktrace.Propagate(badger.ErrKeyNotFound, "key=%s", string(key))
+	}
+	relations, err := d.nodeRelations(t.txn, nodeType, nodeID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if len(relations) > 0 && !detach {
+		return stacktrace.Propagate(constants.ErrConflict, "node %s %s has %v relation(s)", nodeType, nodeID, len(relations))
+	}
+	for _, props := range relations {
+		if err := delRelation(t.txn, props); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	for _, key := range getNodeTypeFieldPaths(nodeType, data, nodeID) {
+		if err := t.txn.Delete(key); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	if err := d.unindexUnique(t, nodeType, data); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if err := addCount(t.txn, getCountPath(nodesPrefix, nodeType), -1); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if err := t.txn.Delete(key); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	t.cache(key, nil)
+	for relationID, props := range relations {
+		t.cache(getRelationPath(cast.ToString(props[Internal_Relation]), relationID), nil)
+	}
+	t.afterCommit(func() {
+		for relationID, props := range relations {
+			d.publishRelation(model.ChangeTypeDelete, cast.ToString(props[Internal_Relation]), relationID, props)
+		}
+		d.publishNode(model.ChangeTypeDelete, nodeType, nodeID, data)
+	})
+	return nil
+}
+
+// addRelation adds or replaces the relation between the node & the other node. Like Node.AddRelation, the direction
+// is relative to the node.
+func (d *DB) addRelation(t *tx, direction api.Direction, relation string, properties map[string]interface{}, node, other model.Key) (*Relation, error) {
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+	relID := getRelationID(node.Type, node.ID, relation, other.Type, other.ID)
+	rkey := getRelationPath(relation, relID)
+	source, target := node, other
+	if direction != api.Outgoing {
+		source, target = other, node
+	}
+	if err := d.validate(relationPrefix, relation, properties, &model.Endpoint{Source: source.Type, Target: target.Type}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	existing, err := getData(t.txn, rkey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if existing == nil {
+		if err := addCount(t.txn, getCountPath(relationPrefix, relation), 1); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	properties[Internal_Direction] = string(direction)
+	properties[Internal_SourceType] = source.Type
+	properties[Internal_SourceID] = source.ID
+	properties[Internal_TargetType] = target.Type
+	properties[Internal_TargetID] = target.ID
+	properties[Internal_ID] = relID
+	properties[Internal_Relation] = relation
+	properties[Internal_Type] = relation
+	properties[Internal_Version] = t.nextVersion(existing)
+
+	bits, err := encode.Marshal(&properties)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if err := t.txn.Set(rkey, bits); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	commitCatalog, err := d.catalog(t.txn, relationCatalogEntries(relation, properties))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	t.afterCommit(commitCatalog)
+	indexes := getRelationFieldPaths(relation, properties, relID)
+	if err := delStale(t.txn, getRelationFieldPaths(relation, existing, relID), indexes); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	keys := [][]byte{
+		getNodeRelationPath(source.Type, source.ID, direction, relation, target.Type, target.ID, relID),
+		getNodeRelationPath(target.Type, target.ID, direction.Opposite(), relation, source.Type, source.ID, relID),
+	}
+	for _, key := range append(keys, indexes...) {
+		if err := t.txn.Set(key, bits); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	r := &Relation{
+		relationType: relation,
+		relationID:   relID,
+		item:         properties,
+		db:           d,
+	}
+	t.cache(rkey, r)
+	t.afterCommit(func() {
+		d.publishRelation(model.ChangeTypeSet, relation, relID, properties)
+	})
+	return r, nil
+}
+
+// setRelation replaces the properties of an existing relation
+func (d *DB) setRelation(t *tx, relation, relationID string, properties map[string]interface{}) (*Relation, error) {
+	existing, err := getData(t.txn, getRelationPath(relation, relationID))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if existing == nil {
+		return nil, stacktrace.Propagate(constants.ErrNotFound, "relation %s %s", relation, relationID)
+	}
+	var (
+		direction = api.Direction(cast.ToString(existing[Internal_Direction]))
+		source    = model.Key{Type: cast.ToString(existing[Internal_SourceType]), ID: cast.ToString(existing[Internal_SourceID])}
+		target    = model.Key{Type: cast.ToString(existing[Internal_TargetType]), ID: cast.ToString(existing[Internal_TargetID])}
+		props     = map[string]interface{}{}
+	)
+	for k, v := range properties {
+		props[k] = v
+	}
+	if direction != api.Outgoing {
+		return d.addRelation(t, direction, relation, props, target, source)
+	}
+	return d.addRelation(t, direction, relation, props, source, target)
+}
+
+// patchRelation applies the patch to the properties of an existing relation
+func (d *DB) patchRelation(t *tx, relation, relationID string, patch *model.Patch) (*Relation, error) {
+	existing, err := getData(t.txn, getRelationPath(relation, relationID))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if existing == nil {
+		return nil, stacktrace.Propagate(constants.ErrNotFound, "relation %s %s", relation, relationID)
+	}
+	props, err := patchProperties(existing, patch)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return d.setRelation(t, relation, relationID, props)
+}
+
+func (d *DB) deleteRelation(t *tx, relation, relationID string) error {
+	rkey := getRelationPath(relation, relationID)
+	props, err := getData(t.txn, rkey)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if props == nil {
+		return stacktrace.Propagate(constants.ErrNotFound, "relation %s %s", relation, relationID)
+	}
+	if err := delRelation(t.txn, props); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	t.cache(rkey, nil)
+	t.afterCommit(func() {
+		d.publishRelation(model.ChangeTypeDelete, relation, relationID, props)
+	})
+	return nil
+}
+
+// Transaction applies the operations in order within a single transaction. It returns the node or relation written by
+// each operation(nil for deletes).
+func (d *DB) Transaction(ops []*model.Op) ([]api.Entity, error) {
+	return d.transaction(0, ops)
+}
+
+func (d *DB) transaction(index uint64, ops []*model.Op) ([]api.Entity, error) {
+	results := make([]api.Entity, len(ops))
+	if err := d.updateAt(index, func(t *tx) error {
+		for i, op := range ops {
+			ent, err := d.applyOp(t, op)
+			if err != nil {
+				return stacktrace.Propagate(err, "operation %v failed", i)
+			}
+			results[i] = ent
+		}
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return results, nil
+}
+
+func (d *DB) applyOp(t *tx, op *model.Op) (api.Entity, error) {
+	if err := validateOp(op); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if err := t.checkVersion(opKey(op)); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	switch {
+	case op.AddNode != nil:
+		return d.addNode(t, op.AddNode.Type, *op.AddNode.ID, op.AddNode.Properties)
+	case op.SetNode != nil:
+		return d.addNode(t, op.SetNode.Type, op.SetNode.ID, op.SetNode.Properties)
+	case op.DelNode != nil:
+		detach := op.DelNode.Detach == nil || *op.DelNode.Detach
+		return nil, d.delNode(t, op.DelNode.Key.Type, op.DelNode.Key.ID, detach)
+	case op.AddRelation != nil:
+		add := op.AddRelation
+		for _, key := range []*model.Key{add.Source, add.Target} {
+			if _, err := t.txn.Get(getNodePath(key.Type, key.ID)); err != nil {
+				return nil, stacktrace.Propagate(err, "node %s %s", key.Type, key.ID)
+			}
+		}
+		direction := api.Outgoing
+		if add.Direction != nil {
+			direction = api.Direction(*add.Direction)
+		}
+		return d.addRelation(t, direction, add.Relation, add.Properties, *add.Source, *add.Target)
+	case op.DelRelation != nil:
+		return nil, d.deleteRelation(t, op.DelRelation.Type, op.DelRelation.ID)
+	case op.Upsert != nil:
+		return d.upsert(t, op.Upsert)
+	case op.PatchProperties != nil && op.PatchProperties.Node != nil:
+		key := op.PatchProperties.Node
+		return d.patchNode(t, key.Type, key.ID, op.PatchProperties.Patch)
+	case op.PatchProperties != nil:
+		key := op.PatchProperties.Relation
+		return d.patchRelation(t, key.Type, key.ID, op.PatchProperties.Patch)
+	}
+	set := replaceOp(op)
+	if set.Node != nil {
+		if _, err := t.txn.Get(getNodePath(set.Node.Type, set.Node.ID)); err != nil {
+			return nil, stacktrace.Propagate(err, "node %s %s", set.Node.Type, set.Node.ID)
+		}
+		return d.addNode(t, set.Node.Type, set.Node.ID, set.Properties)
+	}
+	return d.setRelation(t, set.Relation.Type, set.Relation.ID, set.Properties)
+}
+
+// opKey returns the key of the node or relation written by a write operation with a version precondition
+func opKey(op *model.Op) ([]byte, *int) {
+	switch {
+	case op.SetNode != nil:
+		return getNodePath(op.SetNode.Type, op.SetNode.ID), op.SetNode.IfVersion
+	case op.DelNode != nil:
+		return getNodePath(op.DelNode.Key.Type, op.DelNode.Key.ID), op.DelNode.IfVersion
+	case op.PatchProperties != nil && op.PatchProperties.Node != nil:
+		return getNodePath(op.PatchProperties.Node.Type, op.PatchProperties.Node.ID), op.PatchProperties.IfVersion
+	case op.PatchProperties != nil:
+		return getRelationPath(op.PatchProperties.Relation.Type, op.PatchProperties.Relation.ID), op.PatchProperties.IfVersion
+	}
+	if set := replaceOp(op); set != nil && set.Node != nil {
+		return getNodePath(set.Node.Type, set.Node.ID), set.IfVersion
+	} else if set != nil {
+		return getRelationPath(set.Relation.Type, set.Relation.ID), set.IfVersion
+	}
+	return nil, nil
+}
+
+// replaceOp returns the properties that replace those of a node or relation. setProperties is an alias of
+// replaceProperties.
+func replaceOp(op *model.Op) *model.SetProperties {
+	if op.SetProperties != nil {
+		return op.SetProperties
+	}
+	return op.ReplaceProperties
+}
+
+// validateOp checks that exactly one operation is set & has the keys it needs
+func validateOp(op *model.Op) error {
+	var set int
+	for _, isSet := range []bool{
+		op.AddNode != nil,
+		op.SetNode != nil,
+		op.DelNode != nil,
+		op.AddRelation != nil,
+		op.DelRelation != nil,
+		op.SetProperties != nil,
+		op.ReplaceProperties != nil,
+		op.PatchProperties != nil,
+		op.Upsert != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return stacktrace.NewError("expected exactly one operation, got %v", set)
+	}
+	switch {
+	case op.AddNode != nil && (op.AddNode.ID == nil || *op.AddNode.ID == "" || op.AddNode.Type == ""):
+		return stacktrace.NewError("addNode requires a type & id")
+	case op.SetNode != nil && (op.SetNode.ID == "" || op.SetNode.Type == ""):
+		return stacktrace.NewError("setNode requires a type & id")
+	case op.AddRelation != nil && (op.AddRelation.Source == nil || op.AddRelation.Target == nil || op.AddRelation.Relation == ""):
+		return stacktrace.NewError("addRelation requires a source, target & relation")
+	case replaceOp(op) != nil && (replaceOp(op).Node == nil) == (replaceOp(op).Relation == nil):
+		return stacktrace.NewError("replaceProperties requires either a node or a relation")
+	case op.Upsert != nil && (op.Upsert.ID == nil || *op.Upsert.ID == "" || op.Upsert.Type == "" || len(op.Upsert.Match) == 0):
+		return stacktrace.NewError("upsert requires a type, id & match")
+	case op.PatchProperties != nil && (op.PatchProperties.Node == nil) == (op.PatchProperties.Relation == nil):
+		return stacktrace.NewError("patchProperties requires either a node or a relation")
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package persistence
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/autom8ter/morpheus/pkg/api"
+	"github.com/autom8ter/morpheus/pkg/constants"
+	"github.com/autom8ter/morpheus/pkg/encode"
+	"github.com/autom8ter/morpheus/pkg/graph/model"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/palantir/stacktrace"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// the unique constraints of node definitions are enforced with an index from the values of each constraint's
+// properties to the node that holds them:
+//
+//	8,<node type>,<properties joined by +>,<encoded values> -> node id
+//
+// Nodes that are missing any of a constraint's properties aren't indexed, so they never conflict. Unique constraints are
+// enforced regardless of the mode of the definition.
+
+// parseUnique validates the unique constraints of a definition & returns them with their properties sorted
+func parseUnique(prefix string, unique [][]string) ([][]string, error) {
+	if prefix != nodesPrefix && len(unique) > 0 {
+		return nil, stacktrace.NewError("unique constraints are only supported by node definitions")
+	}
+	var (
+		parsed [][]string
+		seen   = map[string]struct{}{}
+	)
+	for _, fields := range unique {
+		if len(fields) == 0 {
+			return nil, stacktrace.NewError("empty unique constraint")
+		}
+		sorted := append([]string{}, fields...)
+		sort.Strings(sorted)
+		for i, field := range sorted {
+			if _, ok := internalFields[field]; ok || field == "" || strings.ContainsAny(field, ",+") {
+				return nil, stacktrace.NewError("invalid unique property: %q", field)
+			}
+			if i > 0 && sorted[i-1] == field {
+				return nil, stacktrace.NewError("duplicate unique property: %s", field)
+			}
+		}
+		name := strings.Join(sorted, "+")
+		if _, ok := seen[name]; ok {
+			return nil, stacktrace.NewError("duplicate unique constraint: %s", name)
+		}
+		seen[name] = struct{}{}
+		parsed = append(parsed, sorted)
+	}
+	return parsed, nil
+}
+
+func getUniquePrefix(nodeType string, fields []string) []byte {
+	return []byte(strings.Join([]string{uniquePrefix, nodeType, strings.Join(fields, "+"), ""}, ","))
+}
+
+// getUniquePath returns the unique index key of the properties or nil if any of the constraint's properties are missing
+func getUniquePath(nodeType string, fields []string, properties map[string]interface{}) []byte {
+	key := getUniquePrefix(nodeType, fields)
+	for _, field := range fields {
+		value := properties[field]
+		if value == nil {
+			return nil
+		}
+		key = append(key, encodeIndexValue(value)...)
+	}
+	return key
+}
+
+func getUniqueID(txn *badger.Txn, key []byte) (string, error) {
+	item, err := txn.Get(key)
+	if err == badger.ErrKeyNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", stacktrace.Propagate(err, "")
+	}
+	val, err := item.ValueCopy(nil)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "")
+	}
+	return string(val), nil
+}
+
+func (d *DB) uniqueConstraints(nodeType string) [][]string {
+	val, ok := d.nodeDefinitions.Load(nodeType)
+	if !ok {
+		return nil
+	}
+	return val.(*definition).unique
+}
+
+// indexUnique moves the node's unique index keys from its existing properties to its new properties. It fails if
+// another node of the type already holds the values of a unique constraint.
+func (d *DB) indexUnique(t *tx, nodeType, nodeID string, existing, properties map[string]interface{}) error {
+	for _, fields := range d.uniqueConstraints(nodeType) {
+		previous, current := getUniquePath(nodeType, fields, existing), getUniquePath(nodeType, fields, properties)
+		if current != nil {
+			id, err := getUniqueID(t.txn, current)
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+			if id != "" && id != nodeID {
+				return uniqueViolation(nodeType, fields, id)
+			}
+		}
+		if previous != nil && !bytes.Equal(previous, current) {
+			if err := t.txn.Delete(previous); err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		}
+		if current != nil {
+			if err := t.txn.Set(current, []byte(nodeID)); err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		}
+	}
+	return nil
+}
+
+// unindexUnique deletes the unique index keys of a deleted node
+func (d *DB) unindexUnique(t *tx, nodeType string, data map[string]interface{}) error {
+	for _, fields := range d.uniqueConstraints(nodeType) {
+		if key := getUniquePath(nodeType, fields, data); key != nil {
+			if err := t.txn.Delete(key); err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		}
+	}
+	return nil
+}
+
+func uniqueViolation(nodeType string, fields []string, id string) error {
+	return stacktrace.PropagateWithCode(&constants.ValidationError{
+		Type: nodeType,
+		Violations: map[string]string{
+			strings.Join(fields, "+"): fmt.Sprintf("must be unique, already held by %s %s", nodeType, id),
+		},
+	}, http.StatusConflict, "")
+}
+
+// buildUnique indexes the existing nodes of the type for a new unique constraint, failing if any of them conflict
+func (d *DB) buildUnique(t *tx, nodeType string, fields []string) error {
+	opt := badger.DefaultIteratorOptions
+	opt.PrefetchSize = prefetchSize
+	it := t.txn.NewIterator(opt)
+	defer it.Close()
+	prefix := append(getNodePath(nodeType, ""), ',')
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		data := map[string]interface{}{}
+		if err := it.Item().Value(func(val []byte) error {
+			return encode.Unmarshal(val, &data)
+		}); err != nil {
+			return stacktrace.Propagate(err, "key=%s", string(it.Item().Key()))
+		}
+		key := getUniquePath(nodeType, fields, data)
+		if key == nil {
+			continue
+		}
+		nodeID := string(it.Item().Key()[len(prefix):])
+		id, err := getUniqueID(t.txn, key)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		if id != "" && id != nodeID {
+			return uniqueViolation(nodeType, fields, id)
+		}
+		if err := t.txn.Set(key, []byte(nodeID)); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	return nil
+}
+
+// dropUnique deletes the unique index of a constraint
+func (d *DB) dropUnique(t *tx, nodeType string, fields []string) error {
+	opt := badger.DefaultIteratorOptions
+	opt.PrefetchValues = false
+	it := t.txn.NewIterator(opt)
+	defer it.Close()
+	prefix := getUniquePrefix(nodeType, fields)
+	var keys [][]byte
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		keys = append(keys, it.Item().KeyCopy(nil))
+	}
+	for _, key := range keys {
+		if err := t.txn.Delete(key); err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	return nil
+}
+
+// reindexUnique builds the unique indexes of constraints that are added to a node definition & drops those of
+// constraints that are removed from it
+func (d *DB) reindexUnique(t *tx, nodeType string, previous, current [][]string) error {
+	names := func(constraints [][]string) map[string][]string {
+		m := map[string][]string{}
+		for _, fields := range constraints {
+			m[strings.Join(fields, "+")] = fields
+		}
+		return m
+	}
+	before, after := names(previous), names(current)
+	for name, fields := range before {
+		if _, ok := after[name]; !ok {
+			if err := d.dropUnique(t, nodeType, fields); err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		}
+	}
+	for name, fields := range after {
+		if _, ok := before[name]; !ok {
+			if err := d.buildUnique(t, nodeType, fields); err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		}
+	}
+	return nil
+}
+
+// upsert patches the node of the type that holds the values of the match with the properties, or adds a node with
+// the upsert's id & the properties of both if there isn't one. The match's properties must be a unique constraint
+// of the type.
+func (d *DB) upsert(t *tx, u *model.Upsert) (*Node, error) {
+	var fields []string
+	for field := range u.Match {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	var constrained bool
+	for _, constraint := range d.uniqueConstraints(u.Type) {
+		if strings.Join(constraint, "+") == strings.Join(fields, "+") {
+			constrained = true
+			break
+		}
+	}
+	if !constrained {
+		return nil, stacktrace.NewError("%s has no unique constraint on %s", u.Type, strings.Join(fields, "+"))
+	}
+	key := getUniquePath(u.Type, fields, u.Match)
+	if key == nil {
+		return nil, stacktrace.NewError("upsert match values must not be null")
+	}
+	id, err := getUniqueID(t.txn, key)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if id != "" {
+		return d.patchNode(t, u.Type, id, &model.Patch{Set: u.Properties})
+	}
+	props := mergePatch(u.Properties, u.Match)
+	return d.addNode(t, u.Type, *u.ID, props)
+}
+
+func (d *DB) Upsert(u *model.Upsert) (api.Node, error) {
+	if err := validateOp(&model.Op{Upsert: u}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	var n *Node
+	if err := d.update(func(t *tx) error {
+		var err error
+		n, err = d.upsert(t, u)
+		return err
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return n, nil
+}
@@ -0,0 +1,174 @@
+package raft
+
+import (
+	"context"
+	"crypto/sha256"
+	"github.com/hashicorp/memberlist"
+	"github.com/palantir/stacktrace"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Discovery finds the addresses of the servers that may be members of the cluster. Raft & the graphql server share a
+// port so an address is used for both.
+type Discovery interface {
+	Peers(ctx context.Context) ([]string, error)
+	Close() error
+}
+
+type staticDiscovery struct {
+	peers []string
+}
+
+// NewStaticDiscovery returns a Discovery that always returns the given peers
+func NewStaticDiscovery(peers []string) Discovery {
+	var cleaned []string
+	for _, peer := range peers {
+		if peer = strings.TrimSpace(peer); peer != "" {
+			cleaned = append(cleaned, peer)
+		}
+	}
+	return &staticDiscovery{peers: cleaned}
+}
+
+func (s *staticDiscovery) Peers(ctx context.Context) ([]string, error) {
+	return s.peers, nil
+}
+
+func (s *staticDiscovery) Close() error {
+	return nil
+}
+
+type dnsDiscovery struct {
+	name     string
+	port     int
+	srv      bool
+	resolver *net.Resolver
+}
+
+// NewDNSDiscovery returns a Discovery that looks up peers from the A/AAAA records of the name, using the given port. If
+// srv is true, peers are looked up from the SRV records of the name instead, using the port of each record. A headless
+// kubernetes service provides both.
+func NewDNSDiscovery(name string, port int, srv bool) Discovery {
+	return &dnsDiscovery{
+		name:     name,
+		port:     port,
+		srv:      srv,
+		resolver: net.DefaultResolver,
+	}
+}
+
+func (d *dnsDiscovery) Peers(ctx context.Context) ([]string, error) {
+	var peers []string
+	if d.srv {
+		_, records, err := d.resolver.LookupSRV(ctx, "", "", d.name)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "failed to lookup SRV records: %s", d.name)
+		}
+		for _, record := range records {
+			peers = append(peers, net.JoinHostPort(strings.TrimSuffix(record.Target, "."), strconv.Itoa(int(record.Port))))
+		}
+		return peers, nil
+	}
+	hosts, err := d.resolver.LookupHost(ctx, d.name)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to lookup host: %s", d.name)
+	}
+	for _, host := range hosts {
+		peers = append(peers, net.JoinHostPort(host, strconv.Itoa(d.port)))
+	}
+	return peers, nil
+}
+
+func (d *dnsDiscovery) Close() error {
+	return nil
+}
+
+type gossipDiscovery struct {
+	members *memberlist.Memberlist
+	seeds   []string
+}
+
+// GossipOptions configures the gossip membership layer
+type GossipOptions struct {
+	// PeerID is the name of the local member
+	PeerID string
+	// Port is the port that gossip binds to (tcp & udp)
+	Port int
+	// Advertise is the raft address that other members discover the local member by
+	Advertise string
+	// Seeds are the gossip addresses of existing members to join
+	Seeds []string
+	// Secret is used to derive the key that encrypts gossip traffic
+	Secret string
+}
+
+type gossipDelegate struct {
+	meta []byte
+}
+
+func (g *gossipDelegate) NodeMeta(limit int) []byte {
+	return g.meta
+}
+
+func (g *gossipDelegate) NotifyMsg([]byte) {}
+
+func (g *gossipDelegate) GetBroadcasts(overhead, limit int) [][]byte {
+	return nil
+}
+
+func (g *gossipDelegate) LocalState(join bool) []byte {
+	return nil
+}
+
+func (g *gossipDelegate) MergeRemoteState(buf []byte, join bool) {}
+
+// NewGossipDiscovery starts a gossip membership layer & returns a Discovery that returns the raft addresses of every
+// live member. Seeds that can't be reached are ignored so the first member may start before the others.
+func NewGossipDiscovery(opts GossipOptions) (Discovery, error) {
+	config := memberlist.DefaultLANConfig()
+	config.Name = opts.PeerID
+	config.BindPort = opts.Port
+	config.AdvertisePort = opts.Port
+	config.Delegate = &gossipDelegate{meta: []byte(opts.Advertise)}
+	config.LogOutput = ioutil.Discard
+	if opts.Secret != "" {
+		key := sha256.Sum256([]byte(opts.Secret))
+		config.SecretKey = key[:]
+	}
+	members, err := memberlist.Create(config)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to start gossip")
+	}
+	g := &gossipDiscovery{members: members, seeds: opts.Seeds}
+	g.join()
+	return g, nil
+}
+
+// join joins the seeds if no other members are known
+func (g *gossipDiscovery) join() {
+	if len(g.seeds) == 0 || g.members.NumMembers() > 1 {
+		return
+	}
+	g.members.Join(g.seeds)
+}
+
+func (g *gossipDiscovery) Peers(ctx context.Context) ([]string, error) {
+	g.join()
+	var peers []string
+	for _, member := range g.members.Members() {
+		if len(member.Meta) > 0 {
+			peers = append(peers, string(member.Meta))
+		}
+	}
+	return peers, nil
+}
+
+func (g *gossipDiscovery) Close() error {
+	if err := g.members.Leave(0); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return g.members.Shutdown()
+}
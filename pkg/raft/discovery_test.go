@@ -0,0 +1,145 @@
+package raft
+
+import (
+	"context"
+	"github.com/miekg/dns"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestStaticDiscovery(t *testing.T) {
+	d := NewStaticDiscovery([]string{" 10.0.0.1:8080", "", "10.0.0.2:8080 ", " "})
+	defer d.Close()
+	peers, err := d.Peers(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"10.0.0.1:8080", "10.0.0.2:8080"}; !reflect.DeepEqual(peers, expected) {
+		t.Fatalf("expected %v, got: %v", expected, peers)
+	}
+}
+
+// serveDNS starts a dns server on a random local port that answers queries for morpheus.test. with 2 A records & 2 SRV
+// records, & returns a resolver that queries it
+func serveDNS(t *testing.T) *net.Resolver {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := dns.NewServeMux()
+	mux.HandleFunc("morpheus.test.", func(w dns.ResponseWriter, req *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		hdr := dns.RR_Header{Name: req.Question[0].Name, Rrtype: req.Question[0].Qtype, Class: dns.ClassINET, Ttl: 60}
+		switch req.Question[0].Qtype {
+		case dns.TypeA:
+			resp.Answer = []dns.RR{
+				&dns.A{Hdr: hdr, A: net.ParseIP("10.0.0.1")},
+				&dns.A{Hdr: hdr, A: net.ParseIP("10.0.0.2")},
+			}
+		case dns.TypeSRV:
+			resp.Answer = []dns.RR{
+				&dns.SRV{Hdr: hdr, Target: "morpheus-0.morpheus.test.", Port: 8080},
+				&dns.SRV{Hdr: hdr, Target: "morpheus-1.morpheus.test.", Port: 8081},
+			}
+		}
+		w.WriteMsg(resp)
+	})
+	server := &dns.Server{PacketConn: conn, Handler: mux}
+	go server.ActivateAndServe()
+	t.Cleanup(func() {
+		server.Shutdown()
+	})
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "udp", conn.LocalAddr().String())
+		},
+	}
+}
+
+func TestDNSDiscovery(t *testing.T) {
+	resolver := serveDNS(t)
+	for _, test := range []struct {
+		srv      bool
+		expected []string
+	}{
+		{srv: false, expected: []string{"10.0.0.1:8080", "10.0.0.2:8080"}},
+		{srv: true, expected: []string{"morpheus-0.morpheus.test:8080", "morpheus-1.morpheus.test:8081"}},
+	} {
+		d := &dnsDiscovery{name: "morpheus.test.", port: 8080, srv: test.srv, resolver: resolver}
+		peers, err := d.Peers(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(peers)
+		if !reflect.DeepEqual(peers, test.expected) {
+			t.Fatalf("srv=%v: expected %v, got: %v", test.srv, test.expected, peers)
+		}
+	}
+}
+
+func freePort(t *testing.T) int {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	return lis.Addr().(*net.TCPAddr).Port
+}
+
+func TestGossipDiscovery(t *testing.T) {
+	var (
+		port  = freePort(t)
+		peers []Discovery
+	)
+	seed, err := NewGossipDiscovery(GossipOptions{
+		PeerID:    "node-0",
+		Port:      port,
+		Advertise: "10.0.0.1:8080",
+		Secret:    "test-secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	peers = append(peers, seed)
+	// the second member joins the first through its seed
+	d, err := NewGossipDiscovery(GossipOptions{
+		PeerID:    "node-1",
+		Port:      freePort(t),
+		Advertise: "10.0.0.2:8080",
+		Seeds:     []string{net.JoinHostPort("127.0.0.1", strconv.Itoa(port))},
+		Secret:    "test-secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	peers = append(peers, d)
+	defer func() {
+		for _, d := range peers {
+			d.Close()
+		}
+	}()
+	expected := []string{"10.0.0.1:8080", "10.0.0.2:8080"}
+	deadline := time.Now().Add(10 * time.Second)
+	for _, d := range peers {
+		for {
+			found, err := d.Peers(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			sort.Strings(found)
+			if reflect.DeepEqual(found, expected) {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("expected %v, got: %v", expected, found)
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+}
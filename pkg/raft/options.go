@@ -1,11 +1,16 @@
 package raft
 
 import (
+	"crypto/tls"
 	"net"
 	"os"
 	"time"
 )
 
+// DefaultClusterSecret is the cluster secret that's used if none is configured. It's public, so peers are only
+// authenticated by it if mutual TLS is enabled.
+const DefaultClusterSecret = "morpheus"
+
 type Options struct {
 	raftSecret               string
 	raftDir                  string
@@ -21,6 +26,7 @@ type Options struct {
 	commitTimeout            time.Duration
 	leaseTimeout             time.Duration
 	debug                    bool
+	tlsConfig                *tls.Config
 }
 
 func (o *Options) setDefaults() {
@@ -41,7 +47,7 @@ func (o *Options) setDefaults() {
 		o.raftDir = "/tmp/graphik/raft"
 	}
 	if o.raftSecret == "" {
-		o.raftSecret = "morpheus"
+		o.raftSecret = DefaultClusterSecret
 	}
 	os.MkdirAll(o.raftDir, 0700)
 }
@@ -131,3 +137,15 @@ func WithDebug(debug bool) Opt {
 		o.debug = debug
 	}
 }
+
+// WithTLSConfig enables mutual TLS between raft peers in addition to cluster secret authentication
+func WithTLSConfig(tlsConfig *tls.Config) Opt {
+	return func(o *Options) {
+		o.tlsConfig = tlsConfig
+	}
+}
+
+// authenticated returns true if peers are authenticated by something other than the public default cluster secret
+func (o *Options) authenticated() bool {
+	return o.tlsConfig != nil || o.raftSecret != DefaultClusterSecret
+}
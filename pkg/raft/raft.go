@@ -1,9 +1,13 @@
 package raft
 
 import (
+	"context"
 	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"github.com/autom8ter/morpheus/pkg/raft/storage"
 	transport2 "github.com/autom8ter/morpheus/pkg/raft/transport"
 	"github.com/hashicorp/go-hclog"
@@ -12,12 +16,52 @@ import (
 	"github.com/pkg/errors"
 	"net"
 	"os"
+	"sort"
+	"sync/atomic"
 	"time"
 )
 
 type Raft struct {
-	raft *raft.Raft
-	opts *Options
+	raft      *raft.Raft
+	transport *raft.NetworkTransport
+	rpc       *transport2.RPC
+	fsm       *appliedFSM
+	logs      raft.LogStore
+	opts      *Options
+}
+
+// appliedFSM tracks the index of the last command applied by the FSM. Raft's own applied index is advanced once
+// entries are handed to the FSM, before they're applied.
+type appliedFSM struct {
+	raft.FSM
+	snapshots raft.SnapshotStore
+	index     uint64
+}
+
+func (a *appliedFSM) Apply(log *raft.Log) interface{} {
+	result := a.FSM.Apply(log)
+	atomic.StoreUint64(&a.index, log.Index)
+	return result
+}
+
+func (a *appliedFSM) Restore(snapshot io.ReadCloser) error {
+	if err := a.FSM.Restore(snapshot); err != nil {
+		return err
+	}
+	return a.applySnapshot()
+}
+
+// applySnapshot sets the index to that of the latest snapshot, which raft restores from or, if snapshots aren't
+// restored on start, resumes applying the log after
+func (a *appliedFSM) applySnapshot() error {
+	snapshots, err := a.snapshots.List()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if len(snapshots) > 0 {
+		atomic.StoreUint64(&a.index, snapshots[0].Index)
+	}
+	return nil
 }
 
 func NewRaft(fsm raft.FSM, lis net.Listener, opts ...Opt) (*Raft, error) {
@@ -53,7 +97,7 @@ func NewRaft(fsm raft.FSM, lis net.Listener, opts ...Opt) (*Raft, error) {
 	lgger := rlogger{
 		logger: hclog.L(),
 	}
-	transport := transport2.NewNetworkTransport(lis, options.advertise, options.maxPool, options.timeout, lgger)
+	transport, rpc := transport2.NewNetworkTransport(lis, options.advertise, options.maxPool, options.timeout, lgger, options.raftSecret, options.tlsConfig, options.authenticated())
 	snapshots, err := raft.NewFileSnapshotStoreWithLogger(snapshotPath, options.retainSnapshots, lgger)
 	if err != nil {
 		return nil, err
@@ -66,7 +110,11 @@ func NewRaft(fsm raft.FSM, lis net.Listener, opts ...Opt) (*Raft, error) {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	ra, err := raft.NewRaft(config, fsm, strg, strg, snapshots, transport)
+	applied := &appliedFSM{FSM: fsm, snapshots: snapshots}
+	if err := applied.applySnapshot(); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	ra, err := raft.NewRaft(config, applied, strg, strg, snapshots, transport)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
@@ -83,8 +131,12 @@ func NewRaft(fsm raft.FSM, lis net.Listener, opts ...Opt) (*Raft, error) {
 		ra.BootstrapCluster(configuration)
 	}
 	return &Raft{
-		opts: options,
-		raft: ra,
+		opts:      options,
+		raft:      ra,
+		transport: transport,
+		rpc:       rpc,
+		fsm:       applied,
+		logs:      strg,
 	}, nil
 }
 
@@ -142,6 +194,33 @@ func (s *Raft) Join(nodeID, addr string) error {
 	return nil
 }
 
+// Remove removes the server from the cluster. It must be called on the leader.
+func (s *Raft) Remove(nodeID string) error {
+	if err := s.raft.RemoveServer(raft.ServerID(nodeID), 0, 0).Error(); err != nil {
+		return stacktrace.Propagate(err, "failed to remove raft server %s", nodeID)
+	}
+	return nil
+}
+
+// TransferLeadership transfers leadership to the given server, or to the most up to date follower if nodeID is empty.
+// It must be called on the leader.
+func (s *Raft) TransferLeadership(nodeID, addr string) error {
+	var f raft.Future
+	if nodeID == "" {
+		f = s.raft.LeadershipTransfer()
+	} else {
+		f = s.raft.LeadershipTransferToServer(raft.ServerID(nodeID), raft.ServerAddress(addr))
+	}
+	if err := f.Error(); err != nil {
+		return stacktrace.Propagate(err, "failed to transfer leadership")
+	}
+	return nil
+}
+
+func (s *Raft) LocalAddr() string {
+	return string(s.transport.LocalAddr())
+}
+
 func (s *Raft) LeaderAddr() string {
 	return string(s.raft.Leader())
 }
@@ -150,16 +229,137 @@ func (s *Raft) Stats() map[string]string {
 	return s.raft.Stats()
 }
 
-func (s *Raft) Apply(bits []byte) (interface{}, error) {
+// Apply applies the command to the raft log & returns the FSM response along with the index of the log entry.
+// It fails with raft.ErrNotLeader if the node is not the leader.
+func (s *Raft) Apply(bits []byte) (interface{}, uint64, error) {
 	f := s.raft.Apply(bits, s.opts.timeout)
 	if err := f.Error(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	resp := f.Response()
 	if err, ok := resp.(error); ok {
-		return nil, err
+		return nil, f.Index(), err
+	}
+	return resp, f.Index(), nil
+}
+
+// ReadIndex returns an index of the raft log such that a node that has applied it observes every command that was
+// committed before ReadIndex was called. It fails with raft.ErrNotLeader if the node is not the leader.
+//
+// The index is the leader's last log index, read before a quorum confirms with a round of heartbeats that the node is
+// still the leader, so nothing is appended to the log. Every committed command is at or below it, including the no-op
+// a new leader appends at the start of its term. The index may include commands that aren't committed yet, in which
+// case waiting for it to be applied also waits for them.
+func (s *Raft) ReadIndex() (uint64, error) {
+	index := s.raft.LastIndex()
+	if err := s.raft.VerifyLeader().Error(); err != nil {
+		return 0, err
+	}
+	return index, nil
+}
+
+// Bootstrapped returns true if the node is a member of a cluster, either because it was bootstrapped or because it
+// has been added to an existing cluster
+func (s *Raft) Bootstrapped() (bool, error) {
+	servers, err := s.Servers()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	return len(servers) > 0, nil
+}
+
+// Bootstrap bootstraps a new cluster with the given servers(peer id -> address). Every server must be bootstrapped
+// with the same configuration.
+func (s *Raft) Bootstrap(servers map[string]string) error {
+	configuration := raft.Configuration{}
+	for id, addr := range servers {
+		configuration.Servers = append(configuration.Servers, raft.Server{
+			ID:      raft.ServerID(id),
+			Address: raft.ServerAddress(addr),
+		})
+	}
+	sort.Slice(configuration.Servers, func(i, j int) bool {
+		return configuration.Servers[i].ID < configuration.Servers[j].ID
+	})
+	if err := s.raft.BootstrapCluster(configuration).Error(); err != nil {
+		return stacktrace.Propagate(err, "failed to bootstrap cluster")
 	}
-	return resp, nil
+	return nil
+}
+
+func (s *Raft) IsLeader() bool {
+	return s.raft.State() == raft.Leader
+}
+
+// applied returns true if the local FSM has applied every command up to the index. Entries that aren't commands, like
+// the no-op a leader appends at the start of its term, never reach the FSM, so it's enough that raft has dispatched
+// them & the FSM has applied the commands before them.
+func (s *Raft) applied(index uint64) (bool, error) {
+	if s.raft.AppliedIndex() < index {
+		return false, nil
+	}
+	for i := index; i > atomic.LoadUint64(&s.fsm.index); i-- {
+		var log raft.Log
+		if err := s.logs.GetLog(i, &log); err != nil {
+			// entries are only compacted once the FSM has applied them & been snapshotted
+			if err == raft.ErrLogNotFound {
+				return true, nil
+			}
+			return false, stacktrace.Propagate(err, "")
+		}
+		if log.Type == raft.LogCommand {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// WaitForApplied blocks until the local FSM has applied the log entry at the given index
+func (s *Raft) WaitForApplied(index uint64) error {
+	deadline := time.Now().Add(s.opts.timeout)
+	for {
+		applied, err := s.applied(index)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		if applied {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return stacktrace.NewError("timed out waiting for log entry %v to be applied", index)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func (s *Raft) Timeout() time.Duration {
+	return s.opts.timeout
+}
+
+// Authenticated returns true if peers are authenticated by mutual TLS or a cluster secret other than the default
+func (s *Raft) Authenticated() bool {
+	return s.opts.authenticated()
+}
+
+// RPCListener returns the listener for internal requests from other cluster members, or nil if peers aren't
+// authenticated
+func (s *Raft) RPCListener() net.Listener {
+	if s.rpc == nil {
+		return nil
+	}
+	return s.rpc
+}
+
+// DialRPC connects to the rpc listener of the cluster member at the raft address
+func (s *Raft) DialRPC(ctx context.Context, network, addr string) (net.Conn, error) {
+	if s.rpc == nil {
+		return nil, stacktrace.NewError("internal rpcs are disabled: set a cluster secret or enable mutual TLS")
+	}
+	timeout := s.opts.timeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	return s.rpc.Dial(addr, timeout)
 }
 
 func (r *Raft) Close() error {
@@ -176,3 +376,23 @@ func hash(val []byte) string {
 	bs := h.Sum(nil)
 	return hex.EncodeToString(bs)
 }
+
+// MutualTLSConfig returns a TLS config for raft peers that presents the PEM encoded certificate & requires peers to
+// present a certificate signed by the PEM encoded CA
+func MutualTLSConfig(ca, cert, key string) (*tls.Config, error) {
+	pair, err := tls.X509KeyPair([]byte(cert), []byte(key))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to load raft tls certificate")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(ca)) {
+		return nil, stacktrace.NewError("failed to load raft tls ca")
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{pair},
+		RootCAs:      pool,
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package transport
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"github.com/palantir/stacktrace"
+	"io"
+	"net"
+	"sync"
+)
+
+const (
+	nonceSize = 32
+	// maxFrameSize is the maximum number of plaintext bytes in an encrypted frame
+	maxFrameSize = 16 * 1024
+)
+
+type sessionKeys struct {
+	read  []byte
+	write []byte
+}
+
+func mac(secret []byte, label string, parts ...[]byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(label))
+	for _, part := range parts {
+		h.Write(part)
+	}
+	return h.Sum(nil)
+}
+
+// handshake mutually authenticates both ends of the connection with an HMAC challenge over random nonces & derives a
+// key for each direction of the connection. Neither end proves it knows the secret until it has seen the other's nonce,
+// so responses can't be replayed.
+//
+//	client -> server: client nonce
+//	server -> client: server nonce, hmac("server", client nonce, server nonce)
+//	client -> server: hmac("client", client nonce, server nonce)
+func handshake(conn io.ReadWriter, secret []byte, client bool) (*sessionKeys, error) {
+	var (
+		clientNonce = make([]byte, nonceSize)
+		serverNonce = make([]byte, nonceSize)
+	)
+	if client {
+		if _, err := rand.Read(clientNonce); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if _, err := conn.Write(clientNonce); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		challenge := make([]byte, nonceSize+sha256.Size)
+		if _, err := io.ReadFull(conn, challenge); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		copy(serverNonce, challenge[:nonceSize])
+		if !hmac.Equal(challenge[nonceSize:], mac(secret, "server", clientNonce, serverNonce)) {
+			return nil, stacktrace.NewError("peer failed to authenticate with the cluster secret")
+		}
+		if _, err := conn.Write(mac(secret, "client", clientNonce, serverNonce)); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		return &sessionKeys{
+			read:  mac(secret, "server-key", clientNonce, serverNonce),
+			write: mac(secret, "client-key", clientNonce, serverNonce),
+		}, nil
+	}
+	if _, err := io.ReadFull(conn, clientNonce); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if _, err := rand.Read(serverNonce); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if _, err := conn.Write(append(append([]byte{}, serverNonce...), mac(secret, "server", clientNonce, serverNonce)...)); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	response := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(conn, response); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if !hmac.Equal(response, mac(secret, "client", clientNonce, serverNonce)) {
+		return nil, stacktrace.NewError("peer failed to authenticate with the cluster secret")
+	}
+	return &sessionKeys{
+		read:  mac(secret, "client-key", clientNonce, serverNonce),
+		write: mac(secret, "server-key", clientNonce, serverNonce),
+	}, nil
+}
+
+// encryptedConn encrypts each write as a length prefixed AES-GCM frame. Nonces are frame counters, which never repeat
+// because every connection uses new keys.
+type encryptedConn struct {
+	net.Conn
+	readMu     sync.Mutex
+	writeMu    sync.Mutex
+	reader     cipher.AEAD
+	writer     cipher.AEAD
+	readCount  uint64
+	writeCount uint64
+	buf        []byte
+}
+
+func newEncryptedConn(conn net.Conn, keys *sessionKeys) (net.Conn, error) {
+	reader, err := newAEAD(keys.read)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	writer, err := newAEAD(keys.write)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &encryptedConn{
+		Conn:   conn,
+		reader: reader,
+		writer: writer,
+	}, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func frameNonce(aead cipher.AEAD, count uint64) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], count)
+	return nonce
+}
+
+func (e *encryptedConn) Write(b []byte) (int, error) {
+	e.writeMu.Lock()
+	defer e.writeMu.Unlock()
+	var written int
+	for len(b) > 0 {
+		chunk := b
+		if len(chunk) > maxFrameSize {
+			chunk = chunk[:maxFrameSize]
+		}
+		sealed := e.writer.Seal(nil, frameNonce(e.writer, e.writeCount), chunk, nil)
+		e.writeCount++
+		frame := make([]byte, 4+len(sealed))
+		binary.BigEndian.PutUint32(frame, uint32(len(sealed)))
+		copy(frame[4:], sealed)
+		if _, err := e.Conn.Write(frame); err != nil {
+			return written, err
+		}
+		written += len(chunk)
+		b = b[len(chunk):]
+	}
+	return written, nil
+}
+
+func (e *encryptedConn) Read(b []byte) (int, error) {
+	e.readMu.Lock()
+	defer e.readMu.Unlock()
+	if len(e.buf) == 0 {
+		header := make([]byte, 4)
+		if _, err := io.ReadFull(e.Conn, header); err != nil {
+			return 0, err
+		}
+		size := binary.BigEndian.Uint32(header)
+		if size > maxFrameSize+uint32(e.reader.Overhead()) {
+			return 0, stacktrace.NewError("encrypted frame too large: %v", size)
+		}
+		sealed := make([]byte, size)
+		if _, err := io.ReadFull(e.Conn, sealed); err != nil {
+			return 0, err
+		}
+		plain, err := e.reader.Open(sealed[:0], frameNonce(e.reader, e.readCount), sealed, nil)
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "failed to decrypt frame")
+		}
+		e.readCount++
+		e.buf = plain
+	}
+	n := copy(b, e.buf)
+	e.buf = e.buf[n:]
+	return n, nil
+}
@@ -1,31 +1,160 @@
 package transport
 
 import (
+	"crypto/tls"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/raft"
+	"github.com/palantir/stacktrace"
+	"io"
 	"net"
+	"sync"
 	"time"
 )
 
-// streamLayer implements StreamLayer interface for plain TCP.
+const (
+	// handshakeMagic is the first byte written on every raft connection. It's outside of the ascii range so raft
+	// connections are never confused with http requests.
+	handshakeMagic byte = 0xA7
+	// rpcMagic is the first byte written on connections to the internal rpc listener of a cluster member
+	rpcMagic byte = 0xA8
+)
+
+// streamLayer implements StreamLayer interface for TCP connections that are authenticated with the cluster secret.
+// If tlsConfig is set, connections are upgraded to mutual TLS before they're authenticated, otherwise they're
+// encrypted with keys derived from the cluster secret.
 type streamLayer struct {
 	advertise net.Addr
 	listener  net.Listener
+	secret    []byte
+	tlsConfig *tls.Config
+	timeout   time.Duration
+	logger    hclog.Logger
+	conns     chan net.Conn
+	// authenticated is false if the secret is public & mutual TLS is disabled, in which case inbound connections are
+	// refused because anyone could authenticate them
+	authenticated bool
+	// rpcConns are the connections to the internal rpc listener. It's nil if the rpc listener is disabled.
+	rpcConns  chan net.Conn
+	rpcClosed chan struct{}
+	closeOnce sync.Once
+	closed    chan struct{}
 }
 
 // Dial implements the StreamLayer interface.
 func (t *streamLayer) Dial(address raft.ServerAddress, timeout time.Duration) (net.Conn, error) {
-	return net.DialTimeout("tcp", string(address), timeout)
+	return t.dial(string(address), timeout, handshakeMagic)
+}
+
+func (t *streamLayer) dial(address string, timeout time.Duration, magic byte) (net.Conn, error) {
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Now().Add(timeout))
+	if _, err := conn.Write([]byte{magic}); err != nil {
+		conn.Close()
+		return nil, stacktrace.Propagate(err, "")
+	}
+	secured, err := t.secure(conn, address, true)
+	if err != nil {
+		conn.Close()
+		return nil, stacktrace.Propagate(err, "failed to authenticate raft connection to %s", address)
+	}
+	conn.SetDeadline(time.Time{})
+	return secured, nil
+}
+
+func (t *streamLayer) secure(conn net.Conn, address string, client bool) (net.Conn, error) {
+	if t.tlsConfig != nil {
+		var tlsConn *tls.Conn
+		if client {
+			cfg := t.tlsConfig.Clone()
+			if host, _, err := net.SplitHostPort(address); err == nil && cfg.ServerName == "" {
+				cfg.ServerName = host
+			}
+			tlsConn = tls.Client(conn, cfg)
+		} else {
+			tlsConn = tls.Server(conn, t.tlsConfig)
+		}
+		if err := tlsConn.Handshake(); err != nil {
+			return nil, stacktrace.Propagate(err, "tls handshake failed")
+		}
+		if _, err := handshake(tlsConn, t.secret, client); err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		return tlsConn, nil
+	}
+	keys, err := handshake(conn, t.secret, client)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return newEncryptedConn(conn, keys)
+}
+
+// serve accepts connections from the listener & authenticates them concurrently so that a slow or malicious peer can't
+// block other peers from connecting
+func (t *streamLayer) serve() {
+	for {
+		conn, err := t.listener.Accept()
+		if err != nil {
+			t.Close()
+			return
+		}
+		if !t.authenticated {
+			t.logger.Warn("refused raft connection from %s: set a cluster secret or enable mutual TLS to accept peers", conn.RemoteAddr().String())
+			conn.Close()
+			continue
+		}
+		go func() {
+			conn.SetDeadline(time.Now().Add(t.timeout))
+			magic := make([]byte, 1)
+			if _, err := io.ReadFull(conn, magic); err != nil {
+				conn.Close()
+				return
+			}
+			conns, rejected := t.conns, chan struct{}(nil)
+			if magic[0] == rpcMagic {
+				conns, rejected = t.rpcConns, t.rpcClosed
+			}
+			if conns == nil || (magic[0] != handshakeMagic && magic[0] != rpcMagic) {
+				conn.Close()
+				return
+			}
+			secured, err := t.secure(conn, "", false)
+			if err != nil {
+				t.logger.Warn("rejected raft connection from %s: %s", conn.RemoteAddr().String(), stacktrace.RootCause(err).Error())
+				conn.Close()
+				return
+			}
+			conn.SetDeadline(time.Time{})
+			select {
+			case conns <- secured:
+			case <-rejected:
+				secured.Close()
+			case <-t.closed:
+				secured.Close()
+			}
+		}()
+	}
 }
 
 // Accept implements the net.Listener interface.
 func (t *streamLayer) Accept() (c net.Conn, err error) {
-	return t.listener.Accept()
+	select {
+	case conn := <-t.conns:
+		return conn, nil
+	case <-t.closed:
+		return nil, stacktrace.NewError("raft stream layer closed")
+	}
 }
 
 // Close implements the net.Listener interface.
 func (t *streamLayer) Close() (err error) {
-	return t.listener.Close()
+	t.closeOnce.Do(func() {
+		close(t.closed)
+		err = t.listener.Close()
+	})
+	return err
 }
 
 // Addr implements the net.Listener interface.
@@ -36,10 +165,73 @@ func (t *streamLayer) Addr() net.Addr {
 	return t.listener.Addr()
 }
 
-func NewNetworkTransport(lis net.Listener, advertise net.Addr, maxPool int, timeout time.Duration, logger hclog.Logger) *raft.NetworkTransport {
+// NewNetworkTransport returns a raft transport whose connections are authenticated with the cluster secret. If
+// tlsConfig is not nil, connections also use mutual TLS. If authenticated is true, an RPC listener that shares the
+// authentication & encryption of raft connections is returned along with the transport. Otherwise the secret is
+// public, so inbound connections are refused.
+func NewNetworkTransport(lis net.Listener, advertise net.Addr, maxPool int, timeout time.Duration, logger hclog.Logger, secret string, tlsConfig *tls.Config, authenticated bool) (*raft.NetworkTransport, *RPC) {
 	stream := &streamLayer{
-		advertise: advertise,
-		listener:  lis,
+		advertise:     advertise,
+		listener:      lis,
+		secret:        []byte(secret),
+		tlsConfig:     tlsConfig,
+		timeout:       timeout,
+		logger:        logger,
+		conns:         make(chan net.Conn),
+		authenticated: authenticated,
+		closed:        make(chan struct{}),
+	}
+	var listener *RPC
+	if authenticated {
+		stream.rpcConns, stream.rpcClosed = make(chan net.Conn), make(chan struct{})
+		listener = &RPC{stream: stream}
 	}
-	return raft.NewNetworkTransportWithLogger(stream, maxPool, timeout, logger)
+	go stream.serve()
+	return raft.NewNetworkTransportWithLogger(stream, maxPool, timeout, logger), listener
+}
+
+// RPC is a listener for internal requests between cluster members. Its connections are authenticated & encrypted the
+// same way as raft connections.
+type RPC struct {
+	stream    *streamLayer
+	closeOnce sync.Once
+}
+
+// Accept implements the net.Listener interface.
+func (r *RPC) Accept() (net.Conn, error) {
+	select {
+	case conn := <-r.stream.rpcConns:
+		return conn, nil
+	case <-r.stream.rpcClosed:
+		return nil, stacktrace.NewError("rpc listener closed")
+	case <-r.stream.closed:
+		return nil, stacktrace.NewError("raft stream layer closed")
+	}
+}
+
+// Close implements the net.Listener interface. It stops accepting rpc connections but leaves the raft stream layer
+// open.
+func (r *RPC) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.stream.rpcClosed)
+	})
+	return nil
+}
+
+// Addr implements the net.Listener interface.
+func (r *RPC) Addr() net.Addr {
+	return r.stream.Addr()
+}
+
+// Dial connects to the rpc listener of the cluster member at the raft address
+func (r *RPC) Dial(address string, timeout time.Duration) (net.Conn, error) {
+	return r.stream.dial(address, timeout, rpcMagic)
+}
+
+// Matcher matches raft & rpc connections by their first byte. It only reads a single byte because raft rpcs may be
+// shorter than the http/2 preface.
+func Matcher(r io.Reader) bool {
+	buf := make([]byte, 1)
+	n, _ := io.ReadFull(r, buf)
+	return n == 1 && (buf[0] == handshakeMagic || buf[0] == rpcMagic)
 }
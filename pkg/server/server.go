@@ -9,6 +9,7 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/apollotracing"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/autom8ter/morpheus/pkg/api"
 	"github.com/autom8ter/morpheus/pkg/config"
@@ -17,6 +18,7 @@ import (
 	"github.com/autom8ter/morpheus/pkg/logger"
 	"github.com/autom8ter/morpheus/pkg/middleware"
 	"github.com/autom8ter/morpheus/pkg/raft"
+	transport2 "github.com/autom8ter/morpheus/pkg/raft/transport"
 	"github.com/palantir/stacktrace"
 	"github.com/soheilhy/cmux"
 	"golang.org/x/sync/errgroup"
@@ -24,6 +26,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"time"
 )
 
@@ -39,29 +42,52 @@ func Serve(ctx context.Context, g api.Graph, cfg *config.Config) error {
 		return stacktrace.Propagate(err, "")
 	}
 	defer lis.Close()
+	clis := cmux.New(lis)
+
+	tcplis := clis.Match(transport2.Matcher)
+	defer tcplis.Close()
+	glis := clis.Match(cmux.Any())
+	defer glis.Close()
 	if cfg.Server.TLSCert != "" {
 		cer, err := tls.X509KeyPair([]byte(cfg.Server.TLSCert), []byte(cfg.Server.TLSKey))
 		if err != nil {
 			return err
 		}
 		tlsConfig := &tls.Config{Certificates: []tls.Certificate{cer}}
-		lis = tls.NewListener(lis, tlsConfig)
+		glis = tls.NewListener(glis, tlsConfig)
 	}
-	clis := cmux.New(lis)
 
-	glis := clis.Match(cmux.HTTP1(), cmux.HTTP2())
-	defer glis.Close()
-	tcplis := clis.Match(cmux.Any())
-	defer tcplis.Close()
-
-	joinRaft := cfg.Server.RaftCluster
-	rft, err := raft.NewRaft(
-		g.FSM(),
-		tcplis,
+	discover := cfg.Server.RaftCluster != "" || cfg.Server.RaftDNS != "" || cfg.Server.RaftDiscovery == "gossip"
+	raftOpts := []raft.Opt{
 		raft.WithRaftDir(fmt.Sprintf("%s/raft", cfg.Database.StoragePath)),
-		raft.WithIsLeader(joinRaft == ""),
+		raft.WithIsLeader(!discover),
 		raft.WithClusterSecret(cfg.Server.RaftSecret),
-	)
+	}
+	if cfg.Server.RaftTLSCert != "" {
+		tlsConfig, err := raft.MutualTLSConfig(cfg.Server.RaftTLSCA, cfg.Server.RaftTLSCert, cfg.Server.RaftTLSKey)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		raftOpts = append(raftOpts, raft.WithTLSConfig(tlsConfig))
+	}
+	if cfg.Server.RaftPeerID != "" {
+		raftOpts = append(raftOpts, raft.WithPeerID(cfg.Server.RaftPeerID))
+	}
+	if discover && cfg.Server.RaftBroadcast == "" {
+		return stacktrace.NewError("server.raft_broadcast is required to discover a raft cluster")
+	}
+	// the default secret is public, so it can't authenticate peers on its own
+	if discover && cfg.Server.RaftTLSCert == "" && (cfg.Server.RaftSecret == "" || cfg.Server.RaftSecret == raft.DefaultClusterSecret) {
+		return stacktrace.NewError("server.raft_secret must be set to a value other than the default, or server.raft_tls_cert configured, to discover a raft cluster")
+	}
+	if cfg.Server.RaftBroadcast != "" {
+		addr, err := net.ResolveTCPAddr("tcp", cfg.Server.RaftBroadcast)
+		if err != nil {
+			return stacktrace.Propagate(err, "failed to resolve raft broadcast address")
+		}
+		raftOpts = append(raftOpts, raft.WithAdvertiseAddr(addr))
+	}
+	rft, err := raft.NewRaft(g.FSM(), tcplis, raftOpts...)
 	if err != nil {
 		return err
 	}
@@ -73,8 +99,19 @@ func Serve(ctx context.Context, g api.Graph, cfg *config.Config) error {
 		Complexity: generated.ComplexityRoot{},
 	})
 
-	srv := handler.NewDefaultServer(schema)
+	srv := handler.New(schema)
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              mw.WebsocketInit,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
 	srv.SetQueryCache(lru.New(1000))
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
 	mux := http.NewServeMux()
 	if cfg.Features != nil {
 		if cfg.Features.Introspection {
@@ -103,9 +140,16 @@ func Serve(ctx context.Context, g api.Graph, cfg *config.Config) error {
 
 	mux.Handle("/", playground.Handler("GraphQL Console", "/query"))
 
-	mux.Handle("/query", mw.Wrap(srv))
+	mux.Handle("/query", mw.Wrap(resolver.LeaderProxy(srv)))
 
 	server := &http.Server{Handler: mux}
+	// requests from other cluster members are only served on the raft rpc listener, which is only enabled once peers
+	// are authenticated by more than the default cluster secret
+	internal := &http.Server{Handler: resolver.InternalHandler(srv)}
+	rpcLis := rft.RPCListener()
+	if rpcLis == nil {
+		logger.L.Warn("raft peers & internal cluster endpoints are disabled - set server.raft_secret or server.raft_tls_cert to form a cluster", map[string]interface{}{})
+	}
 
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt)
@@ -115,12 +159,33 @@ func Serve(ctx context.Context, g api.Graph, cfg *config.Config) error {
 		clis.Serve()
 		return nil
 	})
+	if discover {
+		discovery, err := newDiscovery(cfg, rft.PeerID())
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		defer discovery.Close()
+		wg.Go(func() error {
+			if err := resolver.DiscoverCluster(ctx, discovery, cfg.Server.RaftExpect); err != nil && ctx.Err() == nil {
+				return stacktrace.Propagate(err, "failed to discover raft cluster")
+			}
+			return nil
+		})
+	}
 	wg.Go(func() error {
 		if err := server.Serve(glis); err != nil && stacktrace.RootCause(err) != http.ErrServerClosed {
 			return stacktrace.Propagate(server.Serve(glis), "")
 		}
 		return nil
 	})
+	if rpcLis != nil {
+		wg.Go(func() error {
+			if err := internal.Serve(rpcLis); err != nil && stacktrace.RootCause(err) != http.ErrServerClosed && ctx.Err() == nil {
+				return stacktrace.Propagate(err, "")
+			}
+			return nil
+		})
+	}
 	select {
 	case <-interrupt:
 		logger.L.Info("shutdown signal received", map[string]interface{}{})
@@ -135,6 +200,9 @@ func Serve(ctx context.Context, g api.Graph, cfg *config.Config) error {
 	if err := server.Shutdown(ctx); err != nil {
 		logger.L.Error("failed to shutdown server", err, map[string]interface{}{})
 	}
+	if err := internal.Shutdown(ctx); err != nil {
+		logger.L.Error("failed to shutdown internal server", err, map[string]interface{}{})
+	}
 	g.Close()
 	rft.Close()
 	tcplis.Close()
@@ -142,3 +210,30 @@ func Serve(ctx context.Context, g api.Graph, cfg *config.Config) error {
 	lis.Close()
 	return wg.Wait()
 }
+
+func newDiscovery(cfg *config.Config, peerID string) (raft.Discovery, error) {
+	switch cfg.Server.RaftDiscovery {
+	case "", "static":
+		return raft.NewStaticDiscovery(strings.Split(cfg.Server.RaftCluster, ",")), nil
+	case "dns":
+		if cfg.Server.RaftDNS == "" {
+			return nil, stacktrace.NewError("server.raft_dns is required for dns discovery")
+		}
+		return raft.NewDNSDiscovery(cfg.Server.RaftDNS, cfg.Server.Port, cfg.Server.RaftDNSSRV), nil
+	case "gossip":
+		var seeds []string
+		for _, seed := range strings.Split(cfg.Server.RaftCluster, ",") {
+			if seed = strings.TrimSpace(seed); seed != "" {
+				seeds = append(seeds, seed)
+			}
+		}
+		return raft.NewGossipDiscovery(raft.GossipOptions{
+			PeerID:    peerID,
+			Port:      cfg.Server.RaftGossipPort,
+			Advertise: cfg.Server.RaftBroadcast,
+			Seeds:     seeds,
+			Secret:    cfg.Server.RaftSecret,
+		})
+	}
+	return nil, stacktrace.NewError("unsupported raft discovery: %s", cfg.Server.RaftDiscovery)
+}